@@ -6,14 +6,31 @@ import (
 	"fmt"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	walletv1 "github.com/safayildirim/wallet-management-service/api/wallet/v1"
+	"github.com/safayildirim/wallet-management-service/internal/address"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/asset"
+	"github.com/safayildirim/wallet-management-service/internal/auth"
+	"github.com/safayildirim/wallet-management-service/internal/feed"
+	"github.com/safayildirim/wallet-management-service/internal/idempotency"
+	"github.com/safayildirim/wallet-management-service/internal/ledger"
+	"github.com/safayildirim/wallet-management-service/internal/network"
+	"github.com/safayildirim/wallet-management-service/internal/outbox"
 	"github.com/safayildirim/wallet-management-service/internal/wallet"
+	"github.com/safayildirim/wallet-management-service/internal/webhook"
 	"github.com/safayildirim/wallet-management-service/pkg/config"
 	"github.com/safayildirim/wallet-management-service/pkg/db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"gorm.io/gorm"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 )
@@ -22,11 +39,19 @@ type Handler interface {
 	RegisterRoutes(e *echo.Group)
 }
 
+// Worker is a background process that runs until its context is cancelled.
+type Worker interface {
+	Run(ctx context.Context) error
+}
+
 type App struct {
-	Config   config.Config
-	DB       *gorm.DB
-	Server   *echo.Echo
-	Handlers []Handler
+	Config     config.Config
+	DB         *gorm.DB
+	Server     *echo.Echo
+	GRPCServer *grpc.Server
+	Health     *health.Server
+	Handlers   []Handler
+	Workers    []Worker
 }
 
 func New() *App {
@@ -42,33 +67,124 @@ func New() *App {
 	// Create Echo instance
 	server := echo.New()
 
-	server.HTTPErrorHandler = func(err error, c echo.Context) {
-		code := http.StatusInternalServerError
-		var he *echo.HTTPError
-		if errors.As(err, &he) {
-			code = he.Code
-		}
-		c.JSON(code, err)
-	}
+	server.HTTPErrorHandler = apperror.NewHTTPErrorHandler()
 
 	// Configure middleware
+	server.Use(middleware.RequestID())
 	server.Use(middleware.Logger())
 	server.Use(middleware.Recover())
+	server.Use(auth.Middleware())
+
+	// Create gRPC server, errors are mapped before the scope is set so that panics are recovered too
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		apperror.UnaryServerInterceptor(),
+		auth.UnaryServerInterceptor(),
+	))
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	reflection.Register(grpcServer)
 
 	var handlers []Handler
+	var workers []Worker
+
+	idempotencyRepository := idempotency.NewRepository(dbInstance)
+	server.Use(idempotency.Middleware(idempotencyRepository, cfg.Idempotency))
+	idempotencySweeper, err := idempotency.NewSweeper(idempotencyRepository, cfg.Idempotency)
+	if err != nil {
+		panic(err)
+	}
+
+	addressRegistry := address.DefaultRegistry()
+
+	networkRepository := network.NewRepository(dbInstance)
+	networkService := network.NewService(networkRepository, addressRegistry)
+	networkHandler := network.NewHandler(networkService)
+
+	assetRepository := asset.NewRepository(dbInstance)
+	assetService := asset.NewService(assetRepository, networkService)
+	assetHandler := asset.NewHandler(assetService)
+
+	outboxRepository := outbox.NewRepository(dbInstance)
+	outboxRelay, err := outbox.NewRelay(outboxRepository, outbox.NewKafkaPublisher(cfg.Kafka), cfg.Outbox)
+	if err != nil {
+		panic(err)
+	}
+	outboxPurger, err := outbox.NewPurger(outboxRepository, cfg.Outbox)
+	if err != nil {
+		panic(err)
+	}
 
 	walletRepository := wallet.NewRepository(dbInstance)
-	walletService := wallet.NewService(walletRepository)
+	walletService := wallet.NewService(walletRepository, networkService, addressRegistry)
 	walletHandler := wallet.NewHandler(walletService)
+	wallet.NewGRPCHandler(walletService).Register(grpcServer)
+	// Addresses stored before they were canonicalized must be rewritten before they are looked up
+	err = wallet.NewAddressBackfill(walletRepository, networkService, addressRegistry).Run(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	walletPurger, err := wallet.NewPurger(walletRepository, cfg.Wallet)
+	if err != nil {
+		panic(err)
+	}
+
+	ledgerRepository := ledger.NewRepository(dbInstance)
+	ledgerService := ledger.NewService(ledgerRepository, walletService, assetService, cfg.Ledger)
+	ledgerHandler := ledger.NewHandler(ledgerService)
+	holdExpirer, err := ledger.NewHoldExpirer(ledgerRepository, cfg.Ledger)
+	if err != nil {
+		panic(err)
+	}
+	depositConsumer := ledger.NewDepositConsumer(
+		ledger.NewDepositReader(cfg.Kafka), ledger.NewDeadLetterWriter(cfg.Kafka), ledgerService, ledgerRepository, cfg.Ledger,
+	)
+	depositRetrier, err := ledger.NewDepositRetrier(ledgerRepository, ledgerService, ledger.NewDeadLetterWriter(cfg.Kafka), cfg.Ledger)
+	if err != nil {
+		panic(err)
+	}
 
-	handlers = append(handlers, walletHandler)
+	webhookRepository := webhook.NewRepository(dbInstance)
+	webhookService := webhook.NewService(webhookRepository)
+	webhookHandler := webhook.NewHandler(webhookService)
+	webhookDispatcher, err := webhook.NewDispatcher(webhookRepository, cfg.Webhook)
+	if err != nil {
+		panic(err)
+	}
+	webhookDeliverer, err := webhook.NewDeliverer(webhookRepository, cfg.Webhook)
+	if err != nil {
+		panic(err)
+	}
 
-	return &App{Config: *cfg, DB: dbInstance, Server: server, Handlers: handlers}
+	feedRepository := feed.NewRepository(dbInstance)
+	feedService := feed.NewService(feedRepository, cfg.EventFeed)
+	feedHandler := feed.NewHandler(feedService, cfg.EventFeed)
+	feedSequencer, err := feed.NewSequencer(feedRepository, cfg.EventFeed)
+	if err != nil {
+		panic(err)
+	}
+	// Long-polling requests and event streams would otherwise hold the shutdown up
+	server.Server.RegisterOnShutdown(feedHandler.Shutdown)
+
+	handlers = append(handlers, networkHandler, assetHandler, walletHandler, ledgerHandler, webhookHandler, feedHandler)
+	workers = append(workers,
+		walletPurger, idempotencySweeper, holdExpirer, outboxRelay, outboxPurger, depositConsumer, depositRetrier,
+		webhookDispatcher, webhookDeliverer, feedSequencer,
+	)
+
+	return &App{
+		Config:     *cfg,
+		DB:         dbInstance,
+		Server:     server,
+		GRPCServer: grpcServer,
+		Health:     healthServer,
+		Handlers:   handlers,
+		Workers:    workers,
+	}
 }
 
 func (a *App) Run() error {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
 
 	route := a.Server.Group("/api")
 
@@ -76,6 +192,23 @@ func (a *App) Run() error {
 		handler.RegisterRoutes(route)
 	}
 
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", a.Config.Grpc.Port))
+	if err != nil {
+		return err
+	}
+
+	// Start the background workers
+	var wg sync.WaitGroup
+	for _, worker := range a.Workers {
+		wg.Add(1)
+		go func(worker Worker) {
+			defer wg.Done()
+			if err := worker.Run(workerCtx); err != nil && !errors.Is(err, context.Canceled) {
+				log.Printf("Worker stopped: %v", err)
+			}
+		}(worker)
+	}
+
 	// Start the server in a goroutine
 	go func() {
 		port := fmt.Sprintf(":%d", a.Config.Http.Port)
@@ -86,20 +219,50 @@ func (a *App) Run() error {
 		}
 	}()
 
+	// Start the gRPC server in a goroutine
+	go func() {
+		log.Println("Starting gRPC server on", lis.Addr())
+		if err := a.GRPCServer.Serve(lis); err != nil {
+			log.Fatalf("Could not start gRPC server: %v", err)
+		}
+	}()
+	a.Health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	a.Health.SetServingStatus(walletv1.WalletService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
 	// Wait for interrupt signal to gracefully shut down the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 	<-quit
 	log.Println("Shutting down server...")
 
+	// Report not serving to health checks while the in-flight calls drain
+	a.Health.Shutdown()
+
 	// Gracefully shut down the Echo server with a timeout
-	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
 	if err := a.Server.Shutdown(ctx); err != nil {
 		log.Fatalf("Server forced to shutdown: %v", err)
 	}
 
+	// Gracefully stop the gRPC server within the same timeout, cutting off the remaining calls after it
+	stopped := make(chan struct{})
+	go func() {
+		a.GRPCServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Println("gRPC server forced to stop")
+		a.GRPCServer.Stop()
+	}
+
+	// Stop the background workers before the database goes away
+	stopWorkers()
+	wg.Wait()
+
 	// Close database connection
 	sqlDB, err := a.DB.DB()
 	if err == nil {
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS wallets_address_pattern_idx;
+DROP INDEX IF EXISTS wallets_network_created_at_id_idx;
+DROP INDEX IF EXISTS wallets_created_at_id_idx;
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS wallets_created_at_id_idx ON wallets (created_at, id);
+CREATE INDEX IF NOT EXISTS wallets_network_created_at_id_idx ON wallets (network, created_at, id);
+CREATE INDEX IF NOT EXISTS wallets_address_pattern_idx ON wallets (address text_pattern_ops);
@@ -0,0 +1,5 @@
+ALTER TABLE wallets
+    DROP COLUMN IF EXISTS "status",
+    DROP COLUMN IF EXISTS "metadata",
+    DROP COLUMN IF EXISTS "label",
+    DROP COLUMN IF EXISTS "version";
@@ -0,0 +1,5 @@
+ALTER TABLE wallets
+    ADD COLUMN IF NOT EXISTS "version"  integer NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS "label"    text    NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS "metadata" jsonb   NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS "status"   text    NOT NULL DEFAULT 'active';
@@ -0,0 +1,6 @@
+DELETE FROM wallets WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS wallets_deleted_at_idx;
+DROP INDEX IF EXISTS wallets_address_network_active_key;
+
+ALTER TABLE wallets ADD CONSTRAINT wallets_address_network_key UNIQUE (address, network);
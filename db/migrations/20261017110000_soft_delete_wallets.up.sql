@@ -0,0 +1,8 @@
+ALTER TABLE wallets DROP CONSTRAINT IF EXISTS wallets_address_network_key;
+
+-- A soft-deleted wallet must not block registering the same address again.
+CREATE UNIQUE INDEX IF NOT EXISTS wallets_address_network_active_key
+    ON wallets (address, network) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS wallets_deleted_at_idx
+    ON wallets (deleted_at) WHERE deleted_at IS NOT NULL;
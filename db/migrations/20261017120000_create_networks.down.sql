@@ -0,0 +1,3 @@
+ALTER TABLE wallets DROP CONSTRAINT IF EXISTS wallets_network_fkey;
+
+DROP TABLE IF EXISTS networks;
@@ -0,0 +1,94 @@
+CREATE TABLE IF NOT EXISTS networks
+(
+    code           text PRIMARY KEY,
+    created_at     timestamp NOT NULL DEFAULT now(),
+    updated_at     timestamp DEFAULT NULL,
+    display_name   text    NOT NULL,
+    chain_id       text,
+    address_format text    NOT NULL,
+    testnet        boolean NOT NULL DEFAULT false,
+    enabled        boolean NOT NULL DEFAULT true
+);
+
+INSERT INTO networks (code, display_name, chain_id, address_format, testnet)
+VALUES ('ethereum', 'Ethereum', '1', 'evm', false),
+       ('polygon', 'Polygon PoS', '137', 'evm', false),
+       ('bsc', 'BNB Smart Chain', '56', 'evm', false),
+       ('arbitrum', 'Arbitrum One', '42161', 'evm', false),
+       ('optimism', 'OP Mainnet', '10', 'evm', false),
+       ('avalanche', 'Avalanche C-Chain', '43114', 'evm', false),
+       ('base', 'Base', '8453', 'evm', false),
+       ('sepolia', 'Sepolia', '11155111', 'evm', true),
+       ('bitcoin', 'Bitcoin', NULL, 'bitcoin', false),
+       ('bitcoin-testnet', 'Bitcoin Testnet', NULL, 'bitcoin-testnet', true),
+       ('tron', 'TRON', NULL, 'tron', false),
+       ('solana', 'Solana', NULL, 'solana', false),
+       ('xrp', 'XRP Ledger', NULL, 'xrp', false)
+ON CONFLICT (code) DO NOTHING;
+
+-- Spellings of the seeded networks found in the free-form wallets.network column.
+CREATE TEMPORARY TABLE network_aliases
+(
+    alias text PRIMARY KEY,
+    code  text NOT NULL
+);
+
+INSERT INTO network_aliases (alias, code)
+VALUES ('eth', 'ethereum'),
+       ('erc20', 'ethereum'),
+       ('ethereum mainnet', 'ethereum'),
+       ('matic', 'polygon'),
+       ('polygon pos', 'polygon'),
+       ('bnb', 'bsc'),
+       ('bep20', 'bsc'),
+       ('bnb smart chain', 'bsc'),
+       ('binance smart chain', 'bsc'),
+       ('arb', 'arbitrum'),
+       ('arbitrum one', 'arbitrum'),
+       ('op', 'optimism'),
+       ('avax', 'avalanche'),
+       ('avalanche c-chain', 'avalanche'),
+       ('btc', 'bitcoin'),
+       ('btc-testnet', 'bitcoin-testnet'),
+       ('bitcoin testnet', 'bitcoin-testnet'),
+       ('trx', 'tron'),
+       ('trc20', 'tron'),
+       ('sol', 'solana'),
+       ('ripple', 'xrp');
+
+CREATE TEMPORARY TABLE normalized_wallets AS
+SELECT w.id, COALESCE(a.code, lower(trim(w.network))) AS network
+FROM wallets w
+         LEFT JOIN network_aliases a ON a.alias = lower(trim(w.network));
+
+-- Spellings of the same network collapse into one natural key; keep the oldest
+-- active wallet and soft-delete the others so the unique index still holds.
+UPDATE wallets
+SET deleted_at = now()
+WHERE id IN (SELECT id
+             FROM (SELECT w.id,
+                          row_number() OVER (PARTITION BY w.address, n.network ORDER BY w.id) AS rn
+                   FROM wallets w
+                            JOIN normalized_wallets n ON n.id = w.id
+                   WHERE w.deleted_at IS NULL) ranked
+             WHERE rn > 1);
+
+UPDATE wallets w
+SET network = n.network
+FROM normalized_wallets n
+WHERE n.id = w.id
+  AND w.network <> n.network;
+
+-- Networks that are not known yet are registered disabled so that the foreign key
+-- holds. Their addresses are taken as they are, so existing wallets are read and looked
+-- up as before; they have to be reviewed before use.
+INSERT INTO networks (code, display_name, address_format, enabled)
+SELECT DISTINCT network, network, 'passthrough', false
+FROM wallets
+ON CONFLICT (code) DO NOTHING;
+
+DROP TABLE normalized_wallets;
+DROP TABLE network_aliases;
+
+ALTER TABLE wallets
+    ADD CONSTRAINT wallets_network_fkey FOREIGN KEY (network) REFERENCES networks (code) ON UPDATE CASCADE;
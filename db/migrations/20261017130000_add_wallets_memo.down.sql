@@ -0,0 +1,13 @@
+DELETE FROM wallets WHERE memo <> '';
+
+DROP INDEX IF EXISTS wallets_network_address_memo_active_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS wallets_address_network_active_key
+    ON wallets (address, network) WHERE deleted_at IS NULL;
+
+ALTER TABLE wallets DROP COLUMN IF EXISTS memo;
+
+DELETE FROM networks WHERE code IN ('stellar', 'cosmoshub')
+  AND NOT EXISTS (SELECT 1 FROM wallets WHERE wallets.network = networks.code);
+
+ALTER TABLE networks DROP COLUMN IF EXISTS memo_format;
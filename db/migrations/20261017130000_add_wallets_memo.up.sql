@@ -0,0 +1,17 @@
+ALTER TABLE networks ADD COLUMN IF NOT EXISTS memo_format text NOT NULL DEFAULT '';
+
+UPDATE networks SET memo_format = 'destination-tag' WHERE address_format = 'xrp';
+
+INSERT INTO networks (code, display_name, chain_id, address_format, memo_format, testnet)
+VALUES ('stellar', 'Stellar', NULL, 'stellar', 'stellar', false),
+       ('cosmoshub', 'Cosmos Hub', 'cosmoshub-4', 'cosmos', 'text', false)
+ON CONFLICT (code) DO NOTHING;
+
+-- An empty memo means the address is not shared, which keeps the unique index
+-- effective for networks without memos (NULLs would never collide).
+ALTER TABLE wallets ADD COLUMN IF NOT EXISTS memo text NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS wallets_address_network_active_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS wallets_network_address_memo_active_key
+    ON wallets (network, address, memo) WHERE deleted_at IS NULL;
@@ -0,0 +1,31 @@
+ALTER TABLE wallets
+    ADD COLUMN IF NOT EXISTS network text,
+    ADD COLUMN IF NOT EXISTS address text,
+    ADD COLUMN IF NOT EXISTS memo    text NOT NULL DEFAULT '';
+
+-- A wallet row holds a single address again: keep the first one attached and drop
+-- the wallets that have none.
+UPDATE wallets w
+SET network = a.network,
+    address = a.address,
+    memo    = a.memo
+FROM (SELECT DISTINCT ON (wallet_id) wallet_id, network, address, memo
+      FROM wallet_addresses
+      ORDER BY wallet_id, id) a
+WHERE a.wallet_id = w.id;
+
+DELETE FROM wallets WHERE network IS NULL;
+
+ALTER TABLE wallets
+    ALTER COLUMN network SET NOT NULL,
+    ALTER COLUMN address SET NOT NULL;
+
+ALTER TABLE wallets
+    ADD CONSTRAINT wallets_network_fkey FOREIGN KEY (network) REFERENCES networks (code) ON UPDATE CASCADE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS wallets_network_address_memo_active_key
+    ON wallets (network, address, memo) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS wallets_network_created_at_id_idx ON wallets (network, created_at, id);
+CREATE INDEX IF NOT EXISTS wallets_address_pattern_idx ON wallets (address text_pattern_ops);
+
+DROP TABLE IF EXISTS wallet_addresses;
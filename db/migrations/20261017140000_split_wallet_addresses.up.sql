@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS wallet_addresses
+(
+    "id"         serial PRIMARY KEY,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+    "deleted_at" timestamp          DEFAULT NULL,
+    "wallet_id"  integer   NOT NULL REFERENCES wallets (id) ON DELETE CASCADE,
+    "network"    text      NOT NULL REFERENCES networks (code) ON UPDATE CASCADE,
+    "address"    text      NOT NULL,
+    "memo"       text      NOT NULL DEFAULT ''
+);
+
+-- Every existing wallet becomes a logical wallet holding its single address; the
+-- address shares the soft-delete marker of its wallet.
+INSERT INTO wallet_addresses (created_at, deleted_at, wallet_id, network, address, memo)
+SELECT created_at, deleted_at, id, network, address, memo
+FROM wallets
+ORDER BY id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS wallet_addresses_network_address_memo_active_key
+    ON wallet_addresses (network, address, memo) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS wallet_addresses_wallet_id_idx ON wallet_addresses (wallet_id);
+CREATE INDEX IF NOT EXISTS wallet_addresses_address_pattern_idx ON wallet_addresses (address text_pattern_ops);
+
+DROP INDEX IF EXISTS wallets_network_address_memo_active_key;
+DROP INDEX IF EXISTS wallets_network_created_at_id_idx;
+DROP INDEX IF EXISTS wallets_address_pattern_idx;
+
+ALTER TABLE wallets DROP CONSTRAINT IF EXISTS wallets_network_fkey;
+ALTER TABLE wallets
+    DROP COLUMN IF EXISTS network,
+    DROP COLUMN IF EXISTS address,
+    DROP COLUMN IF EXISTS memo;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS wallets_owner_id_created_at_id_idx;
+DROP INDEX IF EXISTS wallets_owner_id_id_idx;
+
+ALTER TABLE wallets DROP COLUMN IF EXISTS owner_id;
@@ -0,0 +1,4 @@
+ALTER TABLE wallets ADD COLUMN IF NOT EXISTS owner_id text NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS wallets_owner_id_id_idx ON wallets (owner_id, id);
+CREATE INDEX IF NOT EXISTS wallets_owner_id_created_at_id_idx ON wallets (owner_id, created_at, id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS wallets_metadata_idx;
+DROP INDEX IF EXISTS wallets_tags_idx;
+
+ALTER TABLE wallets DROP COLUMN IF EXISTS tags;
@@ -0,0 +1,4 @@
+ALTER TABLE wallets ADD COLUMN IF NOT EXISTS tags text[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS wallets_tags_idx ON wallets USING gin (tags);
+CREATE INDEX IF NOT EXISTS wallets_metadata_idx ON wallets USING gin (metadata jsonb_path_ops);
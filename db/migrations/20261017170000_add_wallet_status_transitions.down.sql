@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS wallet_status_transitions;
+
+ALTER TABLE wallets DROP CONSTRAINT IF EXISTS wallets_status_check;
+
+UPDATE wallets SET status = 'inactive' WHERE status IN ('frozen', 'closed');
+UPDATE wallets SET status = 'active' WHERE status = 'pending';
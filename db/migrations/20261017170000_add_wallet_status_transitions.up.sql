@@ -0,0 +1,17 @@
+-- Inactive wallets could not be used, which is what frozen means now.
+UPDATE wallets SET status = 'frozen' WHERE status = 'inactive';
+
+ALTER TABLE wallets
+    ADD CONSTRAINT wallets_status_check CHECK (status IN ('pending', 'active', 'frozen', 'closed'));
+
+CREATE TABLE IF NOT EXISTS wallet_status_transitions
+(
+    "id"          serial PRIMARY KEY,
+    "created_at"  timestamp NOT NULL DEFAULT now(),
+    "wallet_id"   integer   NOT NULL REFERENCES wallets (id) ON DELETE CASCADE,
+    "from_status" text      NOT NULL,
+    "to_status"   text      NOT NULL,
+    "reason"      text      NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS wallet_status_transitions_wallet_id_idx ON wallet_status_transitions (wallet_id, id);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS ledger_postings;
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS ledger_accounts;
+
+DROP FUNCTION IF EXISTS ledger_check_entry_balance();
+DROP FUNCTION IF EXISTS ledger_reject_change();
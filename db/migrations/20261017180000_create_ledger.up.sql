@@ -0,0 +1,71 @@
+-- Accounts are not tied to wallets by a foreign key: the ledger is a financial record
+-- and outlives purged wallets.
+CREATE TABLE IF NOT EXISTS ledger_accounts
+(
+    "id"         serial PRIMARY KEY,
+    "created_at" timestamp NOT NULL DEFAULT now(),
+    "kind"       text      NOT NULL CHECK (kind IN ('wallet', 'external')),
+    "wallet_id"  integer            DEFAULT NULL,
+    "asset"      text      NOT NULL,
+    CHECK ((kind = 'wallet') = (wallet_id IS NOT NULL))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ledger_accounts_key ON ledger_accounts (kind, COALESCE(wallet_id, 0), asset);
+
+CREATE TABLE IF NOT EXISTS ledger_entries
+(
+    "id"          serial PRIMARY KEY,
+    "created_at"  timestamp NOT NULL DEFAULT now(),
+    "description" text      NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS ledger_postings
+(
+    "id"         serial PRIMARY KEY,
+    "entry_id"   integer NOT NULL REFERENCES ledger_entries (id),
+    "account_id" integer NOT NULL REFERENCES ledger_accounts (id),
+    "amount"     bigint  NOT NULL CHECK (amount <> 0)
+);
+
+CREATE INDEX IF NOT EXISTS ledger_postings_entry_id_idx ON ledger_postings (entry_id);
+CREATE INDEX IF NOT EXISTS ledger_postings_account_id_idx ON ledger_postings (account_id);
+
+-- Journal entries and their postings are immutable; mistakes are corrected by reversing entries.
+CREATE OR REPLACE FUNCTION ledger_reject_change() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    RAISE EXCEPTION '% rows are immutable', TG_TABLE_NAME;
+END;
+$$;
+
+CREATE TRIGGER ledger_entries_immutable
+    BEFORE UPDATE OR DELETE ON ledger_entries
+    FOR EACH ROW EXECUTE FUNCTION ledger_reject_change();
+
+CREATE TRIGGER ledger_postings_immutable
+    BEFORE UPDATE OR DELETE ON ledger_postings
+    FOR EACH ROW EXECUTE FUNCTION ledger_reject_change();
+
+-- Every entry has to balance to zero per asset once its transaction commits.
+CREATE OR REPLACE FUNCTION ledger_check_entry_balance() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    IF EXISTS (SELECT 1
+               FROM ledger_postings p
+                        JOIN ledger_accounts a ON a.id = p.account_id
+               WHERE p.entry_id = NEW.entry_id
+               GROUP BY a.asset
+               HAVING SUM(p.amount) <> 0) THEN
+        RAISE EXCEPTION 'ledger entry % does not balance', NEW.entry_id;
+    END IF;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE CONSTRAINT TRIGGER ledger_postings_balanced
+    AFTER INSERT ON ledger_postings
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION ledger_check_entry_balance();
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS ledger_transactions
+(
+    "id"            serial PRIMARY KEY,
+    "created_at"    timestamp NOT NULL DEFAULT now(),
+    "wallet_id"     integer   NOT NULL,
+    "entry_id"      integer   NOT NULL UNIQUE REFERENCES ledger_entries (id),
+    "type"          text      NOT NULL CHECK (type IN ('deposit', 'withdrawal')),
+    "asset"         text      NOT NULL,
+    "amount"        bigint    NOT NULL CHECK (amount > 0),
+    "balance_after" bigint    NOT NULL CHECK (balance_after >= 0),
+    "reference"     text      NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS ledger_transactions_wallet_id_idx ON ledger_transactions (wallet_id, id);
+
+CREATE TRIGGER ledger_transactions_immutable
+    BEFORE UPDATE OR DELETE ON ledger_transactions
+    FOR EACH ROW EXECUTE FUNCTION ledger_reject_change();
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS ledger_transfers;
+
+-- Postings are immutable, so existing fee accounts are kept and only new ones are rejected.
+ALTER TABLE ledger_accounts DROP CONSTRAINT IF EXISTS ledger_accounts_kind_check;
+ALTER TABLE ledger_accounts ADD CONSTRAINT ledger_accounts_kind_check CHECK (kind IN ('wallet', 'external')) NOT VALID;
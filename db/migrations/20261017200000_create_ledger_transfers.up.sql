@@ -0,0 +1,23 @@
+ALTER TABLE ledger_accounts DROP CONSTRAINT IF EXISTS ledger_accounts_kind_check;
+ALTER TABLE ledger_accounts ADD CONSTRAINT ledger_accounts_kind_check CHECK (kind IN ('wallet', 'external', 'fee'));
+
+CREATE TABLE IF NOT EXISTS ledger_transfers
+(
+    "id"             serial PRIMARY KEY,
+    "created_at"     timestamp NOT NULL DEFAULT now(),
+    "entry_id"       integer   NOT NULL UNIQUE REFERENCES ledger_entries (id),
+    "from_wallet_id" integer   NOT NULL,
+    "to_wallet_id"   integer   NOT NULL,
+    "asset"          text      NOT NULL,
+    "amount"         bigint    NOT NULL CHECK (amount > 0),
+    "fee"            bigint    NOT NULL DEFAULT 0 CHECK (fee >= 0),
+    "reference"      text      NOT NULL DEFAULT '',
+    CHECK (from_wallet_id <> to_wallet_id)
+);
+
+CREATE INDEX IF NOT EXISTS ledger_transfers_from_wallet_id_idx ON ledger_transfers (from_wallet_id, id);
+CREATE INDEX IF NOT EXISTS ledger_transfers_to_wallet_id_idx ON ledger_transfers (to_wallet_id, id);
+
+CREATE TRIGGER ledger_transfers_immutable
+    BEFORE UPDATE OR DELETE ON ledger_transfers
+    FOR EACH ROW EXECUTE FUNCTION ledger_reject_change();
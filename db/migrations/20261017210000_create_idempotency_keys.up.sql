@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    "owner_id"     text      NOT NULL,
+    "key"          text      NOT NULL,
+    "request_hash" text      NOT NULL,
+    "status_code"  integer   NOT NULL DEFAULT 0,
+    "header"       jsonb,
+    "body"         bytea,
+    "created_at"   timestamp NOT NULL DEFAULT now(),
+    "expires_at"   timestamp NOT NULL,
+    PRIMARY KEY ("owner_id", "key")
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
@@ -0,0 +1,5 @@
+DROP VIEW IF EXISTS wallet_transactions;
+
+DROP INDEX IF EXISTS ledger_transfers_to_wallet_id_entry_id_idx;
+DROP INDEX IF EXISTS ledger_transfers_from_wallet_id_entry_id_idx;
+DROP INDEX IF EXISTS ledger_transactions_wallet_id_entry_id_idx;
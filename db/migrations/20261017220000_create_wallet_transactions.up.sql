@@ -0,0 +1,43 @@
+-- The history of a wallet: deposits, withdrawals and both sides of transfers, one row per
+-- wallet and journal entry. Every branch is served by a (wallet, entry) index, so that a
+-- page of history is read in entry order without sorting the whole history of the wallet.
+CREATE INDEX IF NOT EXISTS ledger_transactions_wallet_id_entry_id_idx ON ledger_transactions (wallet_id, entry_id);
+CREATE INDEX IF NOT EXISTS ledger_transfers_from_wallet_id_entry_id_idx ON ledger_transfers (from_wallet_id, entry_id);
+CREATE INDEX IF NOT EXISTS ledger_transfers_to_wallet_id_entry_id_idx ON ledger_transfers (to_wallet_id, entry_id);
+
+CREATE OR REPLACE VIEW wallet_transactions AS
+SELECT entry_id,
+       created_at,
+       wallet_id,
+       type,
+       'completed'::text AS status,
+       asset,
+       amount,
+       0::bigint         AS fee,
+       NULL::integer     AS counterparty_wallet_id,
+       reference
+FROM ledger_transactions
+UNION ALL
+SELECT entry_id,
+       created_at,
+       from_wallet_id,
+       'transfer_out',
+       'completed',
+       asset,
+       amount,
+       fee,
+       to_wallet_id,
+       reference
+FROM ledger_transfers
+UNION ALL
+SELECT entry_id,
+       created_at,
+       to_wallet_id,
+       'transfer_in',
+       'completed',
+       asset,
+       amount,
+       0,
+       from_wallet_id,
+       reference
+FROM ledger_transfers;
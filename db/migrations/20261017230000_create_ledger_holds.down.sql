@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS ledger_holds;
+
+-- Postings are immutable, so existing hold accounts are kept and only new ones are rejected.
+ALTER TABLE ledger_accounts DROP CONSTRAINT IF EXISTS ledger_accounts_check;
+ALTER TABLE ledger_accounts ADD CONSTRAINT ledger_accounts_check CHECK ((kind = 'wallet') = (wallet_id IS NOT NULL)) NOT VALID;
+ALTER TABLE ledger_accounts DROP CONSTRAINT IF EXISTS ledger_accounts_kind_check;
+ALTER TABLE ledger_accounts ADD CONSTRAINT ledger_accounts_kind_check CHECK (kind IN ('wallet', 'external', 'fee')) NOT VALID;
@@ -0,0 +1,25 @@
+-- Hold accounts belong to wallets just like wallet accounts do.
+ALTER TABLE ledger_accounts DROP CONSTRAINT IF EXISTS ledger_accounts_kind_check;
+ALTER TABLE ledger_accounts ADD CONSTRAINT ledger_accounts_kind_check CHECK (kind IN ('wallet', 'hold', 'external', 'fee'));
+ALTER TABLE ledger_accounts DROP CONSTRAINT IF EXISTS ledger_accounts_check;
+ALTER TABLE ledger_accounts ADD CONSTRAINT ledger_accounts_check CHECK ((kind IN ('wallet', 'hold')) = (wallet_id IS NOT NULL));
+
+CREATE TABLE IF NOT EXISTS ledger_holds
+(
+    "id"              serial PRIMARY KEY,
+    "created_at"      timestamp NOT NULL DEFAULT now(),
+    "wallet_id"       integer   NOT NULL,
+    "asset"           text      NOT NULL,
+    "amount"          bigint    NOT NULL CHECK (amount > 0),
+    "status"          text      NOT NULL DEFAULT 'held' CHECK (status IN ('held', 'captured', 'released', 'expired')),
+    "expires_at"      timestamp NOT NULL,
+    "reference"       text      NOT NULL DEFAULT '',
+    "entry_id"        integer   NOT NULL UNIQUE REFERENCES ledger_entries (id),
+    "settled_at"      timestamp          DEFAULT NULL,
+    "settle_entry_id" integer            DEFAULT NULL UNIQUE REFERENCES ledger_entries (id),
+    "transaction_id"  integer            DEFAULT NULL UNIQUE REFERENCES ledger_transactions (id),
+    CHECK ((status = 'held') = (settle_entry_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS ledger_holds_wallet_id_idx ON ledger_holds (wallet_id, id);
+CREATE INDEX IF NOT EXISTS ledger_holds_expires_at_idx ON ledger_holds (expires_at) WHERE status = 'held';
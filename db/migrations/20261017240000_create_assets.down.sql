@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS assets;
+DROP FUNCTION IF EXISTS assets_reject_decimals_change();
@@ -0,0 +1,47 @@
+CREATE TABLE IF NOT EXISTS assets
+(
+    code       text PRIMARY KEY,
+    created_at timestamp NOT NULL DEFAULT now(),
+    updated_at timestamp DEFAULT NULL,
+    symbol     text      NOT NULL,
+    network    text      NOT NULL REFERENCES networks (code) ON UPDATE CASCADE,
+    contract   text,
+    decimals   integer   NOT NULL CHECK (decimals BETWEEN 0 AND 18),
+    enabled    boolean   NOT NULL DEFAULT true
+);
+
+-- A token is identified by its contract address or denom on the network, and a
+-- network has a single native coin.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_assets_network_contract ON assets (network, contract) WHERE contract IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_assets_network_native ON assets (network) WHERE contract IS NULL;
+
+-- Ledger amounts are scaled by the decimals of their asset, which must never change.
+CREATE OR REPLACE FUNCTION assets_reject_decimals_change() RETURNS trigger
+    LANGUAGE plpgsql AS
+$$
+BEGIN
+    IF NEW.decimals <> OLD.decimals THEN
+        RAISE EXCEPTION 'decimals of asset % are immutable', OLD.code;
+    END IF;
+
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER assets_decimals_immutable
+    BEFORE UPDATE ON assets
+    FOR EACH ROW EXECUTE FUNCTION assets_reject_decimals_change();
+
+INSERT INTO assets (code, symbol, network, contract, decimals)
+VALUES ('BTC', 'BTC', 'bitcoin', NULL, 8),
+       ('ETH', 'ETH', 'ethereum', NULL, 18),
+       ('USDT.ETHEREUM', 'USDT', 'ethereum', '0xdAC17F958D2ee523a2206206994597C13D831ec7', 6),
+       ('USDC.ETHEREUM', 'USDC', 'ethereum', '0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48', 6),
+       ('POL', 'POL', 'polygon', NULL, 18),
+       ('BNB', 'BNB', 'bsc', NULL, 18),
+       ('AVAX', 'AVAX', 'avalanche', NULL, 18),
+       ('TRX', 'TRX', 'tron', NULL, 6),
+       ('USDT.TRON', 'USDT', 'tron', 'TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t', 6),
+       ('SOL', 'SOL', 'solana', NULL, 9),
+       ('XRP', 'XRP', 'xrp', NULL, 6)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,51 @@
+-- Fails if an amount no longer fits into a bigint, or an asset has more than 18 decimals.
+ALTER TABLE assets DROP CONSTRAINT assets_decimals_check;
+ALTER TABLE assets ADD CONSTRAINT assets_decimals_check CHECK (decimals BETWEEN 0 AND 18);
+
+DROP VIEW IF EXISTS wallet_transactions;
+
+ALTER TABLE ledger_holds ALTER COLUMN amount TYPE bigint;
+ALTER TABLE ledger_transfers
+    ALTER COLUMN amount TYPE bigint,
+    ALTER COLUMN fee TYPE bigint;
+ALTER TABLE ledger_transactions
+    ALTER COLUMN amount TYPE bigint,
+    ALTER COLUMN balance_after TYPE bigint;
+ALTER TABLE ledger_postings ALTER COLUMN amount TYPE bigint;
+
+CREATE OR REPLACE VIEW wallet_transactions AS
+SELECT entry_id,
+       created_at,
+       wallet_id,
+       type,
+       'completed'::text AS status,
+       asset,
+       amount,
+       0::bigint         AS fee,
+       NULL::integer     AS counterparty_wallet_id,
+       reference
+FROM ledger_transactions
+UNION ALL
+SELECT entry_id,
+       created_at,
+       from_wallet_id,
+       'transfer_out',
+       'completed',
+       asset,
+       amount,
+       fee,
+       to_wallet_id,
+       reference
+FROM ledger_transfers
+UNION ALL
+SELECT entry_id,
+       created_at,
+       to_wallet_id,
+       'transfer_in',
+       'completed',
+       asset,
+       amount,
+       0,
+       from_wallet_id,
+       reference
+FROM ledger_transfers;
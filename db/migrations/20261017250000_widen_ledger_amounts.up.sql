@@ -0,0 +1,54 @@
+-- Amounts in base units outgrow bigint for 18 decimal assets (10 ETH is 10^19 wei);
+-- numeric(78, 0) holds any 256-bit integer. The history view depends on the columns
+-- and is recreated around the change.
+DROP VIEW IF EXISTS wallet_transactions;
+
+ALTER TABLE ledger_postings ALTER COLUMN amount TYPE numeric(78, 0);
+ALTER TABLE ledger_transactions
+    ALTER COLUMN amount TYPE numeric(78, 0),
+    ALTER COLUMN balance_after TYPE numeric(78, 0);
+ALTER TABLE ledger_transfers
+    ALTER COLUMN amount TYPE numeric(78, 0),
+    ALTER COLUMN fee TYPE numeric(78, 0);
+ALTER TABLE ledger_holds ALTER COLUMN amount TYPE numeric(78, 0);
+
+CREATE OR REPLACE VIEW wallet_transactions AS
+SELECT entry_id,
+       created_at,
+       wallet_id,
+       type,
+       'completed'::text      AS status,
+       asset,
+       amount,
+       0::numeric(78, 0)      AS fee,
+       NULL::integer          AS counterparty_wallet_id,
+       reference
+FROM ledger_transactions
+UNION ALL
+SELECT entry_id,
+       created_at,
+       from_wallet_id,
+       'transfer_out',
+       'completed',
+       asset,
+       amount,
+       fee,
+       to_wallet_id,
+       reference
+FROM ledger_transfers
+UNION ALL
+SELECT entry_id,
+       created_at,
+       to_wallet_id,
+       'transfer_in',
+       'completed',
+       asset,
+       amount,
+       0,
+       from_wallet_id,
+       reference
+FROM ledger_transfers;
+
+-- Decimals were capped so that a whole unit fit into a bigint.
+ALTER TABLE assets DROP CONSTRAINT assets_decimals_check;
+ALTER TABLE assets ADD CONSTRAINT assets_decimals_check CHECK (decimals BETWEEN 0 AND 36);
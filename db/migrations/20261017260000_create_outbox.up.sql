@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS outbox
+(
+    "id"             bigserial PRIMARY KEY,
+    "created_at"     timestamp NOT NULL DEFAULT now(),
+    "aggregate_type" text      NOT NULL,
+    "aggregate_id"   text      NOT NULL,
+    "type"           text      NOT NULL,
+    "payload"        jsonb     NOT NULL,
+    "published_at"   timestamp
+);
+
+-- The relay only ever looks for the unpublished events, oldest first.
+CREATE INDEX IF NOT EXISTS outbox_unpublished_idx ON outbox (id) WHERE published_at IS NULL;
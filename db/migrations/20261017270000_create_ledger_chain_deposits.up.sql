@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS ledger_chain_deposits
+(
+    "id"             serial PRIMARY KEY,
+    "created_at"     timestamp      NOT NULL DEFAULT now(),
+    "network"        text           NOT NULL,
+    "tx_hash"        text           NOT NULL,
+    "output_index"   integer        NOT NULL CHECK (output_index >= 0),
+    "address"        text           NOT NULL,
+    "memo"           text           NOT NULL DEFAULT '',
+    "wallet_id"      integer        NOT NULL,
+    "transaction_id" integer        NOT NULL UNIQUE REFERENCES ledger_transactions (id),
+    "asset"          text           NOT NULL,
+    "amount"         numeric(78, 0) NOT NULL CHECK (amount > 0),
+    -- An output of a chain transaction is credited at most once.
+    UNIQUE ("network", "tx_hash", "output_index")
+);
+
+CREATE INDEX IF NOT EXISTS ledger_chain_deposits_wallet_id_idx ON ledger_chain_deposits (wallet_id, id);
+
+CREATE TRIGGER ledger_chain_deposits_immutable
+    BEFORE UPDATE OR DELETE ON ledger_chain_deposits
+    FOR EACH ROW EXECUTE FUNCTION ledger_reject_change();
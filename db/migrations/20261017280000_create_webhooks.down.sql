@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS outbox_undispatched_idx;
+ALTER TABLE outbox DROP COLUMN IF EXISTS webhooks_dispatched_at;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions
+(
+    "id"                   serial PRIMARY KEY,
+    "created_at"           timestamp NOT NULL DEFAULT now(),
+    "updated_at"           timestamp,
+    "owner_id"             text      NOT NULL DEFAULT '',
+    "url"                  text      NOT NULL,
+    "event_types"          text[]    NOT NULL DEFAULT '{}',
+    "secret"               text      NOT NULL,
+    "enabled"              boolean   NOT NULL DEFAULT true,
+    "consecutive_failures" integer   NOT NULL DEFAULT 0,
+    "disabled_at"          timestamp
+);
+
+CREATE INDEX IF NOT EXISTS webhook_subscriptions_owner_id_idx ON webhook_subscriptions (owner_id);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    "id"               bigserial PRIMARY KEY,
+    "created_at"       timestamp NOT NULL DEFAULT now(),
+    "subscription_id"  integer   NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    "event_id"         bigint    NOT NULL,
+    "event_type"       text      NOT NULL,
+    "payload"          jsonb     NOT NULL,
+    "status"           text      NOT NULL DEFAULT 'pending',
+    "attempts"         integer   NOT NULL DEFAULT 0,
+    "next_attempt_at"  timestamp,
+    "last_attempt_at"  timestamp,
+    "last_status_code" integer,
+    "last_error"       text      NOT NULL DEFAULT '',
+    "delivered_at"     timestamp
+);
+
+-- The deliverer only ever looks for the pending deliveries that are due.
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_deliveries_subscription_id_idx ON webhook_deliveries (subscription_id, id);
+
+-- Events recorded before webhooks existed are not delivered.
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS "webhooks_dispatched_at" timestamp;
+UPDATE outbox SET webhooks_dispatched_at = now();
+
+CREATE INDEX IF NOT EXISTS outbox_undispatched_idx ON outbox (id) WHERE webhooks_dispatched_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS outbox_unsequenced_idx;
+DROP INDEX IF EXISTS outbox_position_idx;
+ALTER TABLE outbox DROP COLUMN IF EXISTS position;
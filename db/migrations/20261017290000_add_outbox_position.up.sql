@@ -0,0 +1,7 @@
+-- The position orders the event feed; it is handed out once an event is committed.
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS "position" bigint;
+UPDATE outbox SET position = id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS outbox_position_idx ON outbox (position);
+-- The sequencer only ever looks for the events without a position, oldest first.
+CREATE INDEX IF NOT EXISTS outbox_unsequenced_idx ON outbox (id) WHERE position IS NULL;
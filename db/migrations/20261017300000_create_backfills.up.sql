@@ -0,0 +1,7 @@
+-- Records the data backfills that cannot be expressed in SQL and are run by the service at
+-- startup, so that each of them completes exactly once.
+CREATE TABLE IF NOT EXISTS backfills
+(
+    "name"         text PRIMARY KEY,
+    "completed_at" timestamp NOT NULL DEFAULT now()
+);
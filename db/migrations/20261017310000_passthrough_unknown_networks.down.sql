@@ -0,0 +1,2 @@
+-- The passthrough format is kept, the unsupported one would break the wallets again.
+SELECT 1;
@@ -0,0 +1,3 @@
+-- Networks registered for legacy wallets used to get an address format no validator
+-- supports, which failed every read and lookup of their wallets.
+UPDATE networks SET address_format = 'passthrough' WHERE address_format = 'unknown';
@@ -0,0 +1,6 @@
+-- An incomplete key is locked for a while only, so that a request whose instance crashed
+-- before recording its response can be retried before the key expires. Keys reserved before
+-- the lock existed get the default lock timeout.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS "locked_until" timestamp;
+UPDATE idempotency_keys SET locked_until = created_at + interval '1 minute' WHERE locked_until IS NULL;
+ALTER TABLE idempotency_keys ALTER COLUMN "locked_until" SET NOT NULL;
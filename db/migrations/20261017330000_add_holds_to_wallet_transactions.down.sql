@@ -0,0 +1,40 @@
+DROP VIEW IF EXISTS wallet_transactions;
+
+CREATE OR REPLACE VIEW wallet_transactions AS
+SELECT entry_id,
+       created_at,
+       wallet_id,
+       type,
+       'completed'::text      AS status,
+       asset,
+       amount,
+       0::numeric(78, 0)      AS fee,
+       NULL::integer          AS counterparty_wallet_id,
+       reference
+FROM ledger_transactions
+UNION ALL
+SELECT entry_id,
+       created_at,
+       from_wallet_id,
+       'transfer_out',
+       'completed',
+       asset,
+       amount,
+       fee,
+       to_wallet_id,
+       reference
+FROM ledger_transfers
+UNION ALL
+SELECT entry_id,
+       created_at,
+       to_wallet_id,
+       'transfer_in',
+       'completed',
+       asset,
+       amount,
+       0,
+       from_wallet_id,
+       reference
+FROM ledger_transfers;
+
+DROP INDEX IF EXISTS ledger_holds_wallet_id_entry_id_idx;
@@ -0,0 +1,53 @@
+-- Holds are part of the history of a wallet too: an open hold is pending and a released or
+-- expired one cancelled. A captured hold is shown as the withdrawal it was captured into.
+CREATE INDEX IF NOT EXISTS ledger_holds_wallet_id_entry_id_idx ON ledger_holds (wallet_id, entry_id);
+
+CREATE OR REPLACE VIEW wallet_transactions AS
+SELECT entry_id,
+       created_at,
+       wallet_id,
+       type,
+       'completed'::text      AS status,
+       asset,
+       amount,
+       0::numeric(78, 0)      AS fee,
+       NULL::integer          AS counterparty_wallet_id,
+       reference
+FROM ledger_transactions
+UNION ALL
+SELECT entry_id,
+       created_at,
+       from_wallet_id,
+       'transfer_out',
+       'completed',
+       asset,
+       amount,
+       fee,
+       to_wallet_id,
+       reference
+FROM ledger_transfers
+UNION ALL
+SELECT entry_id,
+       created_at,
+       to_wallet_id,
+       'transfer_in',
+       'completed',
+       asset,
+       amount,
+       0,
+       from_wallet_id,
+       reference
+FROM ledger_transfers
+UNION ALL
+SELECT entry_id,
+       created_at,
+       wallet_id,
+       'hold',
+       CASE status WHEN 'held' THEN 'pending' ELSE 'cancelled' END,
+       asset,
+       amount,
+       0,
+       NULL,
+       reference
+FROM ledger_holds
+WHERE status <> 'captured';
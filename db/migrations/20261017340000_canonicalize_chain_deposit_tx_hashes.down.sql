@@ -0,0 +1,2 @@
+-- The original case and prefix of the hashes are not kept.
+SELECT 1;
@@ -0,0 +1,17 @@
+-- Hex transaction hashes used to be stored as received, so the same output sent in another case
+-- or without its 0x prefix was credited again. They are now stored lowercased without the
+-- prefix. Outputs credited more than once already keep their original hashes.
+ALTER TABLE ledger_chain_deposits DISABLE TRIGGER ledger_chain_deposits_immutable;
+
+UPDATE ledger_chain_deposits d
+SET tx_hash = lower(regexp_replace(d.tx_hash, '^0[xX]', ''))
+WHERE d.tx_hash ~ '^(0[xX])?[0-9a-fA-F]+$'
+  AND d.tx_hash <> lower(regexp_replace(d.tx_hash, '^0[xX]', ''))
+  AND NOT EXISTS (SELECT 1
+                  FROM ledger_chain_deposits o
+                  WHERE o.id <> d.id
+                    AND o.network = d.network
+                    AND o.output_index = d.output_index
+                    AND lower(regexp_replace(o.tx_hash, '^0[xX]', '')) = lower(regexp_replace(d.tx_hash, '^0[xX]', '')));
+
+ALTER TABLE ledger_chain_deposits ENABLE TRIGGER ledger_chain_deposits_immutable;
@@ -0,0 +1,19 @@
+-- Deposit notifications that cannot be credited yet, such as ones for a frozen wallet or an
+-- address that is not registered yet, wait here instead of holding up their partition.
+CREATE TABLE IF NOT EXISTS ledger_parked_deposits
+(
+    "id"               bigserial PRIMARY KEY,
+    "created_at"       timestamp NOT NULL DEFAULT now(),
+    "source_topic"     text      NOT NULL,
+    "source_partition" integer   NOT NULL,
+    "source_offset"    bigint    NOT NULL,
+    "key"              bytea,
+    "value"            bytea     NOT NULL,
+    "reason"           text      NOT NULL,
+    "attempts"         integer   NOT NULL DEFAULT 1,
+    "next_attempt_at"  timestamp NOT NULL,
+    -- A redelivered notification is parked once.
+    UNIQUE ("source_topic", "source_partition", "source_offset")
+);
+
+CREATE INDEX IF NOT EXISTS ledger_parked_deposits_due_idx ON ledger_parked_deposits (next_attempt_at);
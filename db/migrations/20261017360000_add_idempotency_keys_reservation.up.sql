@@ -0,0 +1,3 @@
+-- A request completes or releases its key only while the key is still reserved for it, and not
+-- once its lock has run out and a retry has reserved the key again.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS "reservation_id" text NOT NULL DEFAULT '';
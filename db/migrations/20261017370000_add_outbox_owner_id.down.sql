@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS outbox_created_at_idx;
+DROP INDEX IF EXISTS outbox_owner_id_position_idx;
+ALTER TABLE outbox DROP COLUMN IF EXISTS owner_id;
@@ -0,0 +1,7 @@
+-- The feed filters the events of an owner, in the order of their positions.
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS "owner_id" text NOT NULL DEFAULT '';
+UPDATE outbox SET owner_id = payload ->> 'owner_id' WHERE payload ->> 'owner_id' IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS outbox_owner_id_position_idx ON outbox (owner_id, position);
+-- The purger only ever looks for the events that are done with, oldest first.
+CREATE INDEX IF NOT EXISTS outbox_created_at_idx ON outbox (created_at);
@@ -0,0 +1,96 @@
+package address
+
+import (
+	"strings"
+
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+)
+
+var (
+	ErrInvalidAddress           = apperror.Validation("invalid_address", "invalid address")
+	ErrUnsupportedAddressFormat = apperror.Validation("unsupported_address_format", "unsupported address format")
+)
+
+// Address formats understood by the default registry. A network refers to
+// exactly one of them, and several networks may share the same format.
+const (
+	FormatEVM            = "evm"
+	FormatBitcoin        = "bitcoin"
+	FormatBitcoinTestnet = "bitcoin-testnet"
+	FormatTron           = "tron"
+	FormatSolana         = "solana"
+	FormatXRP            = "xrp"
+	FormatStellar        = "stellar"
+	FormatCosmos         = "cosmos"
+	FormatPassthrough    = "passthrough"
+)
+
+// Validator checks an address and returns its canonical form, so that
+// addresses which only differ in presentation are stored identically.
+type Validator interface {
+	Canonicalize(address string) (string, error)
+}
+
+// ValidatorFunc adapts a function to the Validator interface.
+type ValidatorFunc func(address string) (string, error)
+
+func (f ValidatorFunc) Canonicalize(address string) (string, error) {
+	return f(address)
+}
+
+// Registry maps address and memo formats to their validators.
+type Registry struct {
+	validators     map[string]Validator
+	memoValidators map[string]Validator
+}
+
+func NewRegistry() *Registry {
+	return &Registry{validators: make(map[string]Validator), memoValidators: make(map[string]Validator)}
+}
+
+// Register sets the validator used for the given address format.
+func (r *Registry) Register(format string, validator Validator) {
+	r.validators[format] = validator
+}
+
+// Supports reports whether a validator is registered for the given address format.
+func (r *Registry) Supports(format string) bool {
+	_, ok := r.validators[format]
+	return ok
+}
+
+// Canonicalize validates the address in the given format and returns its canonical form.
+func (r *Registry) Canonicalize(format, address string) (string, error) {
+	validator, ok := r.validators[format]
+	if !ok {
+		return "", ErrUnsupportedAddressFormat.WithFields(map[string]string{"address_format": "is not supported"})
+	}
+
+	canonical, err := validator.Canonicalize(strings.TrimSpace(address))
+	if err != nil {
+		return "", ErrInvalidAddress.WithFields(map[string]string{"address": err.Error()}).Wrap(err)
+	}
+
+	return canonical, nil
+}
+
+// DefaultRegistry returns a registry with validators for all supported address and memo formats.
+func DefaultRegistry() *Registry {
+	r := NewRegistry()
+
+	r.Register(FormatEVM, ValidatorFunc(CanonicalizeEVM))
+	r.Register(FormatBitcoin, Bitcoin(BitcoinMainnet))
+	r.Register(FormatBitcoinTestnet, Bitcoin(BitcoinTestnet))
+	r.Register(FormatTron, ValidatorFunc(CanonicalizeTron))
+	r.Register(FormatSolana, ValidatorFunc(CanonicalizeSolana))
+	r.Register(FormatXRP, ValidatorFunc(CanonicalizeXRP))
+	r.Register(FormatStellar, ValidatorFunc(CanonicalizeStellar))
+	r.Register(FormatCosmos, Cosmos("cosmos"))
+	r.Register(FormatPassthrough, ValidatorFunc(CanonicalizePassthrough))
+
+	r.RegisterMemo(MemoFormatDestinationTag, ValidatorFunc(CanonicalizeDestinationTag))
+	r.RegisterMemo(MemoFormatStellar, Text(28))
+	r.RegisterMemo(MemoFormatText, Text(256))
+
+	return r
+}
@@ -0,0 +1,263 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry_Canonicalize(t *testing.T) {
+	tests := []struct {
+		name              string
+		format            string
+		address           string
+		expectedCanonical string
+		expectedError     error
+	}{
+		{
+			name:              "when evm address has a valid checksum then should keep it",
+			format:            "evm",
+			address:           "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			expectedCanonical: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		},
+		{
+			name:              "when evm address is lowercase then should checksum it",
+			format:            "evm",
+			address:           "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359",
+			expectedCanonical: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		},
+		{
+			name:              "when evm address is uppercase then should checksum it",
+			format:            "evm",
+			address:           "0xDBF03B407C01E7CD3CBEA99509D93F8DDDC8C6FB",
+			expectedCanonical: "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		},
+		{
+			name:          "when evm address has an invalid checksum then should return error",
+			format:        "evm",
+			address:       "0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			expectedError: ErrInvalidAddress,
+		},
+		{
+			name:          "when evm address is too short then should return error",
+			format:        "evm",
+			address:       "0x1234",
+			expectedError: ErrInvalidAddress,
+		},
+		{
+			name:              "when bitcoin p2pkh address is valid then should keep it",
+			format:            "bitcoin",
+			address:           "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+			expectedCanonical: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+		},
+		{
+			name:              "when bitcoin p2sh address is valid then should keep it",
+			format:            "bitcoin",
+			address:           "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy",
+			expectedCanonical: "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy",
+		},
+		{
+			name:          "when bitcoin base58 checksum is wrong then should return error",
+			format:        "bitcoin",
+			address:       "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3",
+			expectedError: ErrInvalidAddress,
+		},
+		{
+			name:              "when bitcoin bech32 address is uppercase then should lowercase it",
+			format:            "bitcoin",
+			address:           "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4",
+			expectedCanonical: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		},
+		{
+			name:              "when bitcoin bech32m taproot address is valid then should keep it",
+			format:            "bitcoin",
+			address:           "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0",
+			expectedCanonical: "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0",
+		},
+		{
+			name:          "when segwit v0 address uses bech32m then should return error",
+			format:        "bitcoin",
+			address:       "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh",
+			expectedError: ErrInvalidAddress,
+		},
+		{
+			name:          "when testnet address is used on mainnet then should return error",
+			format:        "bitcoin",
+			address:       "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+			expectedError: ErrInvalidAddress,
+		},
+		{
+			name:              "when tron address is valid then should keep it",
+			format:            "tron",
+			address:           "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+			expectedCanonical: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+		},
+		{
+			name:          "when bitcoin address is used on tron then should return error",
+			format:        "tron",
+			address:       "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+			expectedError: ErrInvalidAddress,
+		},
+		{
+			name:              "when solana address is valid then should keep it",
+			format:            "solana",
+			address:           "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+			expectedCanonical: "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+		},
+		{
+			name:          "when solana address contains invalid characters then should return error",
+			format:        "solana",
+			address:       "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5D0",
+			expectedError: ErrInvalidAddress,
+		},
+		{
+			name:              "when xrp address is valid then should keep it",
+			format:            "xrp",
+			address:           "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			expectedCanonical: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		},
+		{
+			name:          "when xrp checksum is wrong then should return error",
+			format:        "xrp",
+			address:       "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTH",
+			expectedError: ErrInvalidAddress,
+		},
+		{
+			name:              "when stellar address is valid then should keep it",
+			format:            "stellar",
+			address:           "GAAZI4TCR3TY5OJHCTJC2A4QSY6CJWJH5IAJTGKIN2ER7LBNVKOCCWN7",
+			expectedCanonical: "GAAZI4TCR3TY5OJHCTJC2A4QSY6CJWJH5IAJTGKIN2ER7LBNVKOCCWN7",
+		},
+		{
+			name:          "when stellar checksum is wrong then should return error",
+			format:        "stellar",
+			address:       "GAAZI4TCR3TY5OJHCTJC2A4QSY6CJWJH5IAJTGKIN2ER7LBNVKOCCWO7",
+			expectedError: ErrInvalidAddress,
+		},
+		{
+			name:              "when cosmos address is uppercase then should lowercase it",
+			format:            "cosmos",
+			address:           "COSMOS1HSK6JRYYQJFHP5DHC55TC9JTCKYGX0EPH6DD02",
+			expectedCanonical: "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02",
+		},
+		{
+			name:          "when cosmos address has another prefix then should return error",
+			format:        "cosmos",
+			address:       "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+			expectedError: ErrInvalidAddress,
+		},
+		{
+			name:              "when format is passthrough then should keep address as given",
+			format:            "passthrough",
+			address:           " DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L ",
+			expectedCanonical: "DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L",
+		},
+		{
+			name:          "when passthrough address is blank then should return error",
+			format:        "passthrough",
+			address:       "  ",
+			expectedError: ErrInvalidAddress,
+		},
+		{
+			name:          "when address format is unknown then should return error",
+			format:        "dogecoin",
+			address:       "DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L",
+			expectedError: ErrUnsupportedAddressFormat,
+		},
+	}
+
+	registry := DefaultRegistry()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canonical, err := registry.Canonicalize(tt.format, tt.address)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCanonical, canonical)
+			}
+		})
+	}
+}
+
+func TestRegistry_CanonicalizeMemo(t *testing.T) {
+	tests := []struct {
+		name              string
+		format            string
+		memo              string
+		expectedCanonical string
+		expectedError     error
+	}{
+		{
+			name:              "when memo is empty then should accept it on any network",
+			format:            "",
+			memo:              "",
+			expectedCanonical: "",
+		},
+		{
+			name:              "when destination tag has leading zeros then should strip them",
+			format:            MemoFormatDestinationTag,
+			memo:              "000123",
+			expectedCanonical: "123",
+		},
+		{
+			name:          "when destination tag overflows uint32 then should return error",
+			format:        MemoFormatDestinationTag,
+			memo:          "4294967296",
+			expectedError: ErrInvalidMemo,
+		},
+		{
+			name:          "when destination tag is not a number then should return error",
+			format:        MemoFormatDestinationTag,
+			memo:          "customer-1",
+			expectedError: ErrInvalidMemo,
+		},
+		{
+			name:              "when stellar memo fits then should keep it",
+			format:            MemoFormatStellar,
+			memo:              "customer 1",
+			expectedCanonical: "customer 1",
+		},
+		{
+			name:          "when stellar memo is longer than 28 bytes then should return error",
+			format:        MemoFormatStellar,
+			memo:          "this memo is way too long for stellar",
+			expectedError: ErrInvalidMemo,
+		},
+		{
+			name:          "when text memo has control characters then should return error",
+			format:        MemoFormatText,
+			memo:          "line\nbreak",
+			expectedError: ErrInvalidMemo,
+		},
+		{
+			name:          "when network does not support memos then should return error",
+			format:        "",
+			memo:          "123",
+			expectedError: ErrMemoNotSupported,
+		},
+		{
+			name:          "when memo format is unknown then should return error",
+			format:        "payment-id",
+			memo:          "123",
+			expectedError: ErrUnsupportedMemoFormat,
+		},
+	}
+
+	registry := DefaultRegistry()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canonical, err := registry.CanonicalizeMemo(tt.format, tt.memo)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCanonical, canonical)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package address
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	bitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	rippleAlphabet  = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+)
+
+var errInvalidChecksum = errors.New("invalid checksum")
+
+// decodeBase58 decodes s using the given 58 character alphabet.
+func decodeBase58(s, alphabet string) ([]byte, error) {
+	if s == "" {
+		return nil, errors.New("empty base58 string")
+	}
+
+	result := new(big.Int)
+	radix := big.NewInt(58)
+	for _, c := range []byte(s) {
+		index := bytes.IndexByte([]byte(alphabet), c)
+		if index < 0 {
+			return nil, errors.Errorf("invalid base58 character %q", c)
+		}
+		result.Mul(result, radix)
+		result.Add(result, big.NewInt(int64(index)))
+	}
+
+	// Every leading zero digit encodes a leading zero byte.
+	zeros := 0
+	for zeros < len(s) && s[zeros] == alphabet[0] {
+		zeros++
+	}
+
+	return append(make([]byte, zeros), result.Bytes()...), nil
+}
+
+// decodeBase58Check decodes a base58 string whose last four bytes are the
+// double SHA-256 checksum of the payload, and returns the payload.
+func decodeBase58Check(s, alphabet string) ([]byte, error) {
+	decoded, err := decodeBase58(s, alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(decoded) < 5 {
+		return nil, errors.New("invalid length")
+	}
+
+	payload, checksum := decoded[:len(decoded)-4], decoded[len(decoded)-4:]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(second[:4], checksum) {
+		return nil, errInvalidChecksum
+	}
+
+	return payload, nil
+}
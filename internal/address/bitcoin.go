@@ -0,0 +1,73 @@
+package address
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// BitcoinParams holds the address prefixes of a Bitcoin network.
+type BitcoinParams struct {
+	HRP        string
+	PubKeyHash byte
+	ScriptHash byte
+}
+
+var (
+	BitcoinMainnet = BitcoinParams{HRP: "bc", PubKeyHash: 0x00, ScriptHash: 0x05}
+	BitcoinTestnet = BitcoinParams{HRP: "tb", PubKeyHash: 0x6f, ScriptHash: 0xc4}
+)
+
+// Bitcoin returns a validator for Base58Check P2PKH/P2SH and Bech32/Bech32m segwit
+// addresses. Segwit addresses are canonicalized to lowercase.
+func Bitcoin(params BitcoinParams) Validator {
+	return ValidatorFunc(func(address string) (string, error) {
+		if strings.HasPrefix(strings.ToLower(address), params.HRP+"1") {
+			return canonicalizeSegwit(params.HRP, address)
+		}
+
+		payload, err := decodeBase58Check(address, bitcoinAlphabet)
+		if err != nil {
+			return "", err
+		}
+		if len(payload) != 21 {
+			return "", errors.New("invalid length")
+		}
+		if payload[0] != params.PubKeyHash && payload[0] != params.ScriptHash {
+			return "", errors.New("unknown address version")
+		}
+
+		return address, nil
+	})
+}
+
+func canonicalizeSegwit(hrp, address string) (string, error) {
+	decodedHRP, data, constant, err := decodeBech32(address)
+	if err != nil {
+		return "", err
+	}
+	if decodedHRP != hrp || len(data) == 0 {
+		return "", errors.New("invalid segwit address")
+	}
+
+	version := data[0]
+	program, err := convertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case version > 16:
+		return "", errors.New("invalid witness version")
+	case len(program) < 2 || len(program) > 40:
+		return "", errors.New("invalid witness program length")
+	case version == 0 && len(program) != 20 && len(program) != 32:
+		return "", errors.New("invalid witness program length")
+	case version == 0 && constant != bech32Const:
+		return "", errors.New("witness version 0 requires bech32")
+	case version != 0 && constant != bech32mConst:
+		return "", errors.New("witness version 1+ requires bech32m")
+	}
+
+	return strings.ToLower(address), nil
+}
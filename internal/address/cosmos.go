@@ -0,0 +1,31 @@
+package address
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Cosmos returns a validator for Bech32 account addresses of a Cosmos SDK chain with
+// the given human readable part. Addresses are canonicalized to lowercase.
+func Cosmos(hrp string) Validator {
+	return ValidatorFunc(func(address string) (string, error) {
+		decodedHRP, data, constant, err := decodeBech32(address)
+		if err != nil {
+			return "", err
+		}
+		if decodedHRP != hrp || constant != bech32Const {
+			return "", errors.New("not a " + hrp + " account address")
+		}
+
+		account, err := convertBits(data, 5, 8, false)
+		if err != nil {
+			return "", err
+		}
+		if len(account) != 20 && len(account) != 32 {
+			return "", errors.New("invalid account length")
+		}
+
+		return strings.ToLower(address), nil
+	})
+}
@@ -0,0 +1,50 @@
+package address
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+)
+
+// CanonicalizeEVM validates a 20 byte hex address used by Ethereum and EVM compatible
+// chains. Mixed-case input must carry a valid EIP-55 checksum; the canonical form is
+// the checksummed address.
+func CanonicalizeEVM(address string) (string, error) {
+	if !strings.HasPrefix(address, "0x") || len(address) != 42 {
+		return "", errors.New("must be 0x followed by 40 hex characters")
+	}
+
+	digits := address[2:]
+	if _, err := hex.DecodeString(digits); err != nil {
+		return "", errors.New("must be 0x followed by 40 hex characters")
+	}
+
+	checksummed := eip55(strings.ToLower(digits))
+	if strings.ToLower(digits) != digits && strings.ToUpper(digits) != digits && checksummed != address {
+		return "", errors.Wrap(errInvalidChecksum, "EIP-55")
+	}
+
+	return checksummed, nil
+}
+
+// eip55 applies the EIP-55 mixed-case checksum to lowercase hex digits.
+func eip55(digits string) string {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(digits))
+	sum := hash.Sum(nil)
+
+	result := []byte(digits)
+	for i, c := range result {
+		nibble := sum[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+		if c >= 'a' && nibble&0x0f >= 8 {
+			result[i] = c - 'a' + 'A'
+		}
+	}
+
+	return "0x" + string(result)
+}
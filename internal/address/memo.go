@@ -0,0 +1,89 @@
+package address
+
+import (
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+)
+
+var (
+	ErrInvalidMemo           = apperror.Validation("invalid_memo", "invalid memo")
+	ErrMemoNotSupported      = apperror.Validation("memo_not_supported", "network does not support memos")
+	ErrUnsupportedMemoFormat = apperror.Validation("unsupported_memo_format", "unsupported memo format")
+)
+
+// Memo formats understood by the default registry. A memo (destination tag on XRP)
+// tells apart the customers sharing a single deposit address.
+const (
+	MemoFormatDestinationTag = "destination-tag"
+	MemoFormatStellar        = "stellar"
+	MemoFormatText           = "text"
+)
+
+// RegisterMemo sets the validator used for the given memo format.
+func (r *Registry) RegisterMemo(format string, validator Validator) {
+	r.memoValidators[format] = validator
+}
+
+// SupportsMemo reports whether a validator is registered for the given memo format.
+func (r *Registry) SupportsMemo(format string) bool {
+	_, ok := r.memoValidators[format]
+	return ok
+}
+
+// CanonicalizeMemo validates the memo in the given format and returns its canonical form.
+// An empty memo is always valid; an empty format means the network does not support memos.
+func (r *Registry) CanonicalizeMemo(format, memo string) (string, error) {
+	if memo == "" {
+		return "", nil
+	}
+	if format == "" {
+		return "", ErrMemoNotSupported.WithFields(map[string]string{"memo": "is not supported on this network"})
+	}
+
+	validator, ok := r.memoValidators[format]
+	if !ok {
+		return "", ErrUnsupportedMemoFormat.WithFields(map[string]string{"memo_format": "is not supported"})
+	}
+
+	canonical, err := validator.Canonicalize(memo)
+	if err != nil {
+		return "", ErrInvalidMemo.WithFields(map[string]string{"memo": err.Error()}).Wrap(err)
+	}
+
+	return canonical, nil
+}
+
+// CanonicalizeDestinationTag validates an XRP Ledger destination tag, an unsigned
+// 32-bit integer, and returns it without leading zeros.
+func CanonicalizeDestinationTag(memo string) (string, error) {
+	tag, err := strconv.ParseUint(memo, 10, 32)
+	if err != nil {
+		return "", errors.New("must be an integer between 0 and 4294967295")
+	}
+
+	return strconv.FormatUint(tag, 10), nil
+}
+
+// Text returns a validator for free-form memos of at most maxBytes bytes of
+// printable UTF-8 text. Memos are kept as given.
+func Text(maxBytes int) Validator {
+	return ValidatorFunc(func(memo string) (string, error) {
+		if len(memo) > maxBytes {
+			return "", errors.Errorf("must be at most %d bytes", maxBytes)
+		}
+		if !utf8.ValidString(memo) {
+			return "", errors.New("must be valid utf-8")
+		}
+		for _, r := range memo {
+			if !unicode.IsPrint(r) {
+				return "", errors.New("must not contain control characters")
+			}
+		}
+
+		return memo, nil
+	})
+}
@@ -0,0 +1,14 @@
+package address
+
+import "github.com/pkg/errors"
+
+// CanonicalizePassthrough accepts any non-blank address as given. It serves the networks whose
+// format is not known, such as those registered for legacy wallets, which behave as before
+// addresses were validated until they are given a real format.
+func CanonicalizePassthrough(address string) (string, error) {
+	if address == "" {
+		return "", errors.New("cannot be blank")
+	}
+
+	return address, nil
+}
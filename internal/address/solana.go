@@ -0,0 +1,20 @@
+package address
+
+import "github.com/pkg/errors"
+
+// CanonicalizeSolana validates a base58 encoded 32 byte Solana public key.
+func CanonicalizeSolana(address string) (string, error) {
+	if len(address) < 32 || len(address) > 44 {
+		return "", errors.New("invalid length")
+	}
+
+	decoded, err := decodeBase58(address, bitcoinAlphabet)
+	if err != nil {
+		return "", err
+	}
+	if len(decoded) != 32 {
+		return "", errors.New("must encode 32 bytes")
+	}
+
+	return address, nil
+}
@@ -0,0 +1,49 @@
+package address
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+// stellarAccountVersion is the strkey version byte of an ed25519 public key ("G...").
+const stellarAccountVersion = 6 << 3
+
+// CanonicalizeStellar validates a Stellar account address encoded as a strkey.
+func CanonicalizeStellar(address string) (string, error) {
+	if len(address) != 56 {
+		return "", errors.New("invalid length")
+	}
+
+	decoded, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(address)
+	if err != nil {
+		return "", errors.New("invalid base32 encoding")
+	}
+	if len(decoded) != 35 || decoded[0] != stellarAccountVersion {
+		return "", errors.New("not a stellar account address")
+	}
+
+	payload, checksum := decoded[:33], binary.LittleEndian.Uint16(decoded[33:])
+	if crc16XModem(payload) != checksum {
+		return "", errInvalidChecksum
+	}
+
+	return address, nil
+}
+
+func crc16XModem(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
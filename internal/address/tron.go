@@ -0,0 +1,18 @@
+package address
+
+import "github.com/pkg/errors"
+
+const tronVersion = 0x41
+
+// CanonicalizeTron validates a Base58Check Tron address ("T...").
+func CanonicalizeTron(address string) (string, error) {
+	payload, err := decodeBase58Check(address, bitcoinAlphabet)
+	if err != nil {
+		return "", err
+	}
+	if len(payload) != 21 || payload[0] != tronVersion {
+		return "", errors.New("not a tron address")
+	}
+
+	return address, nil
+}
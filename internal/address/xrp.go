@@ -0,0 +1,18 @@
+package address
+
+import "github.com/pkg/errors"
+
+const xrpAccountVersion = 0x00
+
+// CanonicalizeXRP validates a classic XRP Ledger account address ("r...").
+func CanonicalizeXRP(address string) (string, error) {
+	payload, err := decodeBase58Check(address, rippleAlphabet)
+	if err != nil {
+		return "", err
+	}
+	if len(payload) != 21 || payload[0] != xrpAccountVersion {
+		return "", errors.New("not an xrp account address")
+	}
+
+	return address, nil
+}
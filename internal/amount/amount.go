@@ -0,0 +1,210 @@
+package amount
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// MaxDigits is the number of digits of the largest amount the database stores in a
+// numeric(78, 0) column, enough for any 256-bit integer.
+const MaxDigits = 78
+
+var integerPattern = regexp.MustCompile(`^-?[0-9]+$`)
+
+// Amount is an amount of an asset in integer base units, e.g. satoshi or wei, of arbitrary
+// precision. The zero value is zero. Amounts are immutable; arithmetic returns new amounts.
+//
+// Amounts are encoded as JSON strings, since JSON numbers lose precision beyond 2^53 in most
+// clients, and are stored in numeric columns.
+type Amount struct {
+	// i is nil for zero and never modified, so that equal amounts are deeply equal as well.
+	i *big.Int
+}
+
+// New returns the amount of the given number of base units.
+func New(v int64) Amount {
+	return fromBig(big.NewInt(v))
+}
+
+// FromBig returns the amount of the given number of base units.
+func FromBig(v *big.Int) Amount {
+	return fromBig(new(big.Int).Set(v))
+}
+
+// Parse parses a number of base units such as "-150000000".
+func Parse(s string) (Amount, error) {
+	if !integerPattern.MatchString(s) {
+		return Amount{}, errors.Errorf("invalid amount %q", s)
+	}
+
+	v, _ := new(big.Int).SetString(s, 10)
+	return fromBig(v), nil
+}
+
+// MustParse is like Parse but panics if s is not an integer; it is meant for constants and tests.
+func MustParse(s string) Amount {
+	a, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return a
+}
+
+// fromBig wraps v, which must not be modified afterwards.
+func fromBig(v *big.Int) Amount {
+	if v.Sign() == 0 {
+		return Amount{}
+	}
+
+	return Amount{i: v}
+}
+
+// int returns the value of a for reading only.
+func (a Amount) int() *big.Int {
+	if a.i == nil {
+		return new(big.Int)
+	}
+
+	return a.i
+}
+
+// Big returns a copy of the value of a.
+func (a Amount) Big() *big.Int {
+	return new(big.Int).Set(a.int())
+}
+
+// Add returns a + b.
+func (a Amount) Add(b Amount) Amount {
+	return fromBig(new(big.Int).Add(a.int(), b.int()))
+}
+
+// Sub returns a - b.
+func (a Amount) Sub(b Amount) Amount {
+	return fromBig(new(big.Int).Sub(a.int(), b.int()))
+}
+
+// Mul returns a * b.
+func (a Amount) Mul(b Amount) Amount {
+	return fromBig(new(big.Int).Mul(a.int(), b.int()))
+}
+
+// Quo returns a / b rounded with the given mode, e.g. to split an amount or apply a rate.
+func (a Amount) Quo(b Amount, mode RoundingMode) (Amount, error) {
+	if b.IsZero() {
+		return Amount{}, errors.New("division by zero")
+	}
+
+	q, err := quo(a.int(), b.int(), mode)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	return fromBig(q), nil
+}
+
+// Neg returns -a.
+func (a Amount) Neg() Amount {
+	return fromBig(new(big.Int).Neg(a.int()))
+}
+
+// Abs returns |a|.
+func (a Amount) Abs() Amount {
+	return fromBig(new(big.Int).Abs(a.int()))
+}
+
+// Cmp compares a and b, returning -1 if a < b, 0 if a == b and +1 if a > b.
+func (a Amount) Cmp(b Amount) int {
+	return a.int().Cmp(b.int())
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of a.
+func (a Amount) Sign() int {
+	return a.int().Sign()
+}
+
+// IsZero reports whether a is zero.
+func (a Amount) IsZero() bool {
+	return a.i == nil
+}
+
+// String returns the number of base units in decimal.
+func (a Amount) String() string {
+	return a.int().String()
+}
+
+// Decimal formats a in whole units of an asset with the given decimals, the inverse of
+// Decimal.Units: 150000000 with 8 decimals is "1.5".
+func (a Amount) Decimal(decimals int) Decimal {
+	return formatDecimal(a.int(), decimals)
+}
+
+// digits returns the number of decimal digits of a, ignoring the sign.
+func (a Amount) digits() int {
+	return len(new(big.Int).Abs(a.int()).String())
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(a.String())), nil
+}
+
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.New("amount must be a string of base units")
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*a = parsed
+	return nil
+}
+
+// Scan implements sql.Scanner for numeric columns. Integral values with a fractional part of
+// zeros, as returned for sums, are accepted.
+func (a *Amount) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case int64:
+		*a = New(v)
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case nil:
+		return errors.New("cannot scan NULL into an amount")
+	default:
+		return errors.Errorf("cannot scan %T into an amount", src)
+	}
+
+	parsed, err := Decimal(s).Units(0, RoundExact)
+	if err != nil {
+		return errors.Wrapf(err, "cannot scan %q into an amount", s)
+	}
+
+	*a = parsed
+	return nil
+}
+
+// Value implements driver.Valuer; Postgres casts the decimal string to numeric.
+func (a Amount) Value() (driver.Value, error) {
+	if a.digits() > MaxDigits {
+		return nil, errors.Errorf("amount exceeds %d digits", MaxDigits)
+	}
+
+	return a.String(), nil
+}
+
+// GormDataType declares the column type of amounts.
+func (Amount) GormDataType() string {
+	return "numeric"
+}
@@ -0,0 +1,115 @@
+package amount
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAmount_Arithmetic(t *testing.T) {
+	// 10 ETH in wei does not fit into an int64.
+	tenEther := MustParse("10000000000000000000")
+
+	assert.Equal(t, MustParse("10000000000000000001"), tenEther.Add(New(1)))
+	assert.Equal(t, MustParse("-9999999999999999999"), New(1).Sub(tenEther))
+	assert.Equal(t, MustParse("100000000000000000000000000000000000000"), tenEther.Mul(tenEther))
+	assert.Equal(t, tenEther, tenEther.Neg().Abs())
+	assert.Equal(t, Amount{}, tenEther.Sub(tenEther))
+	assert.True(t, tenEther.Sub(tenEther).IsZero())
+	assert.Equal(t, -1, tenEther.Neg().Sign())
+	assert.Equal(t, 1, tenEther.Cmp(New(1)))
+	assert.Equal(t, 0, New(0).Cmp(Amount{}))
+	assert.Equal(t, "-10000000000000000000", tenEther.Neg().String())
+	assert.Equal(t, big.NewInt(5), New(5).Big())
+}
+
+func TestAmount_Quo(t *testing.T) {
+	tests := []struct {
+		name          string
+		a             Amount
+		b             Amount
+		mode          RoundingMode
+		expected      Amount
+		expectedError string
+	}{
+		{name: "when division is exact then should return quotient", a: New(10), b: New(5), mode: RoundExact, expected: New(2)},
+		{name: "when exact division is inexact then should return error", a: New(10), b: New(4), mode: RoundExact, expectedError: ErrInexact.Error()},
+		{name: "when rounding down then should truncate", a: New(-7), b: New(2), mode: RoundDown, expected: New(-3)},
+		{name: "when rounding up then should round away from zero", a: New(-7), b: New(2), mode: RoundUp, expected: New(-4)},
+		{name: "when rounding half up a tie then should round away from zero", a: New(5), b: New(2), mode: RoundHalfUp, expected: New(3)},
+		{name: "when rounding half even a tie then should round to even", a: New(5), b: New(2), mode: RoundHalfEven, expected: New(2)},
+		{name: "when rounding half even a negative tie then should round to even", a: New(-7), b: New(2), mode: RoundHalfEven, expected: New(-4)},
+		{name: "when rounding half even below a tie then should round down", a: New(13), b: New(10), mode: RoundHalfEven, expected: New(1)},
+		{name: "when dividing by zero then should return error", a: New(1), b: Amount{}, mode: RoundDown, expectedError: "division by zero"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.a.Quo(tt.b, tt.mode)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestAmount_JSON(t *testing.T) {
+	var v struct {
+		Amount Amount `json:"amount"`
+	}
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount":"123456789012345678901234567890"}`), &v))
+	assert.Equal(t, MustParse("123456789012345678901234567890"), v.Amount)
+
+	raw, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount":"123456789012345678901234567890"}`, string(raw))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":1.5}`), &v))
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":"1.5"}`), &v))
+}
+
+func TestAmount_Scan(t *testing.T) {
+	tests := []struct {
+		name          string
+		src           any
+		expected      Amount
+		expectedError bool
+	}{
+		{name: "when numeric is scanned then should parse it", src: []byte("-25000000000000000000"), expected: MustParse("-25000000000000000000")},
+		{name: "when sum has zero fraction then should parse it", src: "42.000", expected: New(42)},
+		{name: "when integer is scanned then should keep it", src: int64(7), expected: New(7)},
+		{name: "when numeric has a fraction then should return error", src: []byte("1.5"), expectedError: true},
+		{name: "when value is NULL then should return error", src: nil, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result Amount
+			err := result.Scan(tt.src)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestAmount_Value(t *testing.T) {
+	value, err := MustParse("-25000000000000000000").Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "-25000000000000000000", value)
+
+	_, err = MustParse(strings.Repeat("9", MaxDigits+1)).Value()
+	assert.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package amount
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var decimalPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// Decimal is an amount in whole units of an asset, such as "1.5", as given by clients. Like
+// json.Number it keeps the text it was given in; it is only converted to base units once the
+// decimals of the asset are known.
+type Decimal string
+
+// Valid reports whether d is a decimal number.
+func (d Decimal) Valid() bool {
+	return decimalPattern.MatchString(string(d))
+}
+
+// Units converts d into base units of an asset with the given decimals: "1.5" with 8 decimals
+// is 150000000. Digits beyond the decimals of the asset are rounded with the given mode, so
+// RoundExact rejects them.
+func (d Decimal) Units(decimals int, mode RoundingMode) (Amount, error) {
+	if !d.Valid() {
+		return Amount{}, errors.New("must be a decimal number")
+	}
+
+	s := string(d)
+	negative := strings.HasPrefix(s, "-")
+	whole, fraction, _ := strings.Cut(strings.TrimPrefix(s, "-"), ".")
+	fraction = strings.TrimRight(fraction, "0")
+
+	// Bound the work before parsing: an amount whose whole digits scale past MaxDigits is too large,
+	// and no asset has more decimals than an amount has digits.
+	if len(strings.TrimLeft(whole, "0"))+decimals > MaxDigits {
+		return Amount{}, errors.New("is too large")
+	}
+	if len(fraction) > MaxDigits {
+		return Amount{}, errors.Errorf("must have at most %d decimal places", MaxDigits)
+	}
+
+	v, _ := new(big.Int).SetString(whole+fraction, 10)
+	if negative {
+		v.Neg(v)
+	}
+
+	if scale := decimals - len(fraction); scale >= 0 {
+		v.Mul(v, pow10(scale))
+	} else {
+		var err error
+		if v, err = quo(v, pow10(-scale), mode); err != nil {
+			return Amount{}, errors.Errorf("must have at most %d decimal places", decimals)
+		}
+	}
+
+	units := fromBig(v)
+	if units.digits() > MaxDigits {
+		return Amount{}, errors.New("is too large")
+	}
+
+	return units, nil
+}
+
+// formatDecimal formats v base units in whole units of an asset with the given decimals,
+// without trailing zeros.
+func formatDecimal(v *big.Int, decimals int) Decimal {
+	digits := new(big.Int).Abs(v).String()
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+
+	s := digits[:len(digits)-decimals]
+	if fraction := strings.TrimRight(digits[len(digits)-decimals:], "0"); fraction != "" {
+		s += "." + fraction
+	}
+	if v.Sign() < 0 {
+		s = "-" + s
+	}
+
+	return Decimal(s)
+}
+
+// pow10 returns 10^n.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
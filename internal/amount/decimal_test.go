@@ -0,0 +1,71 @@
+package amount
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_Units(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         Decimal
+		decimals      int
+		mode          RoundingMode
+		expected      Amount
+		expectedError string
+	}{
+		{name: "when amount is whole then should scale it", value: "2", decimals: 8, expected: New(200000000)},
+		{name: "when amount has a fraction then should scale it", value: "0.015", decimals: 8, expected: New(1500000)},
+		{name: "when fraction has trailing zeros then should ignore them", value: "1.5000000000", decimals: 6, expected: New(1500000)},
+		{name: "when asset has no decimals then should keep amount", value: "42", decimals: 0, expected: New(42)},
+		{name: "when amount is zero then should return zero", value: "0.000", decimals: 18, expected: Amount{}},
+		{name: "when amount is negative then should scale it", value: "-1.25", decimals: 2, expected: New(-125)},
+		{name: "when amount exceeds an int64 then should scale it", value: "1000000.000000000000000001", decimals: 18, expected: MustParse("1000000000000000000000001")},
+		{name: "when fraction exceeds decimals exactly then should return error", value: "0.123", decimals: 2, expectedError: "must have at most 2 decimal places"},
+		{name: "when fraction exceeds decimals rounding down then should truncate", value: "0.129", decimals: 2, mode: RoundDown, expected: New(12)},
+		{name: "when fraction exceeds decimals rounding half even then should round", value: "0.125", decimals: 2, mode: RoundHalfEven, expected: New(12)},
+		{name: "when fraction exceeds decimals rounding half up then should round", value: "0.125", decimals: 2, mode: RoundHalfUp, expected: New(13)},
+		{name: "when amount exceeds maximum digits then should return error", value: Decimal(strings.Repeat("9", MaxDigits-17)), decimals: 18, expectedError: "is too large"},
+		{name: "when whole part is too long then should return error before scaling", value: Decimal(strings.Repeat("9", 100000)), decimals: 18, expectedError: "is too large"},
+		{name: "when whole part has leading zeros then should ignore them", value: Decimal(strings.Repeat("0", 100) + "1"), decimals: 2, expected: New(100)},
+		{name: "when fraction is too long then should return error before rounding", value: Decimal("0." + strings.Repeat("1", 100000)), decimals: 2, mode: RoundDown, expectedError: "must have at most 78 decimal places"},
+		{name: "when amount uses an exponent then should return error", value: "1e8", decimals: 8, expectedError: "must be a decimal number"},
+		{name: "when amount has no integer part then should return error", value: ".5", decimals: 8, expectedError: "must be a decimal number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.value.Units(tt.decimals, tt.mode)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestAmount_Decimal(t *testing.T) {
+	tests := []struct {
+		amount   Amount
+		decimals int
+		expected Decimal
+	}{
+		{amount: New(150000000), decimals: 8, expected: "1.5"},
+		{amount: New(1), decimals: 18, expected: "0.000000000000000001"},
+		{amount: New(-125), decimals: 2, expected: "-1.25"},
+		{amount: New(42), decimals: 0, expected: "42"},
+		{amount: Amount{}, decimals: 6, expected: "0"},
+		{amount: MustParse("25000000000000000000"), decimals: 18, expected: "25"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.expected), func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.amount.Decimal(tt.decimals))
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package amount
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// RoundingMode decides how a result that falls between two amounts is rounded.
+type RoundingMode int
+
+const (
+	// RoundExact rejects results that cannot be represented exactly.
+	RoundExact RoundingMode = iota
+	// RoundDown rounds towards zero, truncating the result.
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundHalfUp rounds to the nearest amount, and ties away from zero.
+	RoundHalfUp
+	// RoundHalfEven rounds to the nearest amount, and ties to the even one.
+	RoundHalfEven
+)
+
+// ErrInexact is returned by RoundExact for results that would have to be rounded.
+var ErrInexact = errors.New("result cannot be represented exactly")
+
+// quo returns x / y rounded with the given mode; y must not be zero.
+func quo(x, y *big.Int, mode RoundingMode) (*big.Int, error) {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Sign() == 0 {
+		return q, nil
+	}
+
+	// QuoRem truncates; rounding away from zero moves the quotient by the sign of the result.
+	away := big.NewInt(int64(x.Sign() * y.Sign()))
+	switch mode {
+	case RoundExact:
+		return nil, ErrInexact
+	case RoundDown:
+	case RoundUp:
+		q.Add(q, away)
+	case RoundHalfUp, RoundHalfEven:
+		half := new(big.Int).Abs(r)
+		half.Lsh(half, 1)
+		c := half.CmpAbs(y)
+		if c > 0 || (c == 0 && (mode == RoundHalfUp || q.Bit(0) == 1)) {
+			q.Add(q, away)
+		}
+	default:
+		return nil, errors.Errorf("unknown rounding mode %d", mode)
+	}
+
+	return q, nil
+}
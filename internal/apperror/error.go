@@ -0,0 +1,151 @@
+package apperror
+
+import (
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+// Kind classifies an Error and decides how it is reported to clients.
+type Kind string
+
+const (
+	KindValidation           Kind = "validation"
+	KindNotFound             Kind = "not_found"
+	KindAlreadyExists        Kind = "already_exists"
+	KindConflict             Kind = "conflict"
+	KindForbidden            Kind = "forbidden"
+	KindPreconditionFailed   Kind = "precondition_failed"
+	KindPreconditionRequired Kind = "precondition_required"
+	KindUnprocessable        Kind = "unprocessable"
+	KindInternal             Kind = "internal"
+)
+
+// Error is a domain error with a stable, machine-readable code. Its message is
+// considered safe to show to clients, unlike the wrapped cause.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Fields  map[string]string
+	cause   error
+}
+
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.cause)
+	}
+
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is reports errors with the same code as equal, so that errors derived from a
+// sentinel with Wrap or WithFields still match it.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+
+	return e.Code == t.Code
+}
+
+// Wrap returns a copy of e that carries cause as its underlying error.
+func (e *Error) Wrap(cause error) *Error {
+	c := *e
+	c.cause = cause
+
+	return &c
+}
+
+// WithFields returns a copy of e that carries per-field details.
+func (e *Error) WithFields(fields map[string]string) *Error {
+	c := *e
+	c.Fields = fields
+
+	return &c
+}
+
+func New(kind Kind, code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+func Validation(code, message string) *Error {
+	return New(KindValidation, code, message)
+}
+
+func NotFound(code, message string) *Error {
+	return New(KindNotFound, code, message)
+}
+
+func AlreadyExists(code, message string) *Error {
+	return New(KindAlreadyExists, code, message)
+}
+
+func Conflict(code, message string) *Error {
+	return New(KindConflict, code, message)
+}
+
+func Forbidden(code, message string) *Error {
+	return New(KindForbidden, code, message)
+}
+
+func PreconditionFailed(code, message string) *Error {
+	return New(KindPreconditionFailed, code, message)
+}
+
+func PreconditionRequired(code, message string) *Error {
+	return New(KindPreconditionRequired, code, message)
+}
+
+func Unprocessable(code, message string) *Error {
+	return New(KindUnprocessable, code, message)
+}
+
+var (
+	ErrInvalidRequest = Validation("invalid_request", "invalid request")
+	ErrInvalidParam   = Validation("invalid_parameter", "invalid parameter")
+)
+
+// InvalidRequest reports a request that could not be bound or failed validation.
+// Field errors produced by ozzo-validation are exposed as per-field details.
+func InvalidRequest(err error) *Error {
+	// Binding errors carry a client-facing message; drop the status code noise around it.
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		err = errors.New(fmt.Sprint(httpErr.Message))
+	}
+
+	var fieldErrors validation.Errors
+	if errors.As(err, &fieldErrors) {
+		fields := make(map[string]string, len(fieldErrors))
+		for field, fieldErr := range fieldErrors {
+			fields[field] = fieldErr.Error()
+		}
+
+		return ErrInvalidRequest.WithFields(fields).Wrap(err)
+	}
+
+	return ErrInvalidRequest.Wrap(err)
+}
+
+// InvalidParam reports a malformed path or query parameter.
+func InvalidParam(name string, err error) *Error {
+	return ErrInvalidParam.WithFields(map[string]string{name: err.Error()}).Wrap(err)
+}
+
+// KindOf returns the kind of the first Error in err's chain, or KindInternal.
+func KindOf(err error) Kind {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Kind
+	}
+
+	return KindInternal
+}
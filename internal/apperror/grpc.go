@@ -0,0 +1,105 @@
+package apperror
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the google.rpc.ErrorInfo details of gRPC statuses.
+const ErrorDomain = "wallet-management-service"
+
+var codeByKind = map[Kind]codes.Code{
+	KindValidation:           codes.InvalidArgument,
+	KindNotFound:             codes.NotFound,
+	KindAlreadyExists:        codes.AlreadyExists,
+	KindConflict:             codes.FailedPrecondition,
+	KindForbidden:            codes.PermissionDenied,
+	KindPreconditionFailed:   codes.Aborted,
+	KindPreconditionRequired: codes.FailedPrecondition,
+	KindUnprocessable:        codes.FailedPrecondition,
+	KindInternal:             codes.Internal,
+}
+
+// CodeOf returns the gRPC status code the error is reported with.
+func CodeOf(err error) codes.Code {
+	return codeByKind[KindOf(err)]
+}
+
+// ToStatus converts an error into a gRPC status. Like problem details, it carries the message
+// and the code of domain errors, the latter as the reason of an ErrorInfo detail, and per-field
+// validation errors as a BadRequest detail. Messages of unexpected errors are not exposed.
+func ToStatus(err error) *status.Status {
+	problem := ToProblem(err)
+	st := status.New(CodeOf(err), problem.Detail)
+
+	info := &errdetails.ErrorInfo{Reason: problem.Code, Domain: ErrorDomain}
+	if len(problem.Errors) == 0 {
+		if withDetails, err := st.WithDetails(info); err == nil {
+			st = withDetails
+		}
+
+		return st
+	}
+
+	fields := make([]string, 0, len(problem.Errors))
+	for field := range problem.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	badRequest := &errdetails.BadRequest{}
+	for _, field := range fields {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: problem.Errors[field],
+		})
+	}
+
+	if withDetails, err := st.WithDetails(info, badRequest); err == nil {
+		st = withDetails
+	}
+
+	return st
+}
+
+// UnaryServerInterceptor reports the errors returned by gRPC handlers as statuses. Unexpected
+// errors, panics included, are logged instead of being exposed to the client.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := recoverHandler(ctx, req, handler)
+		if err == nil {
+			return resp, nil
+		}
+
+		// Statuses, such as those of unimplemented methods, are reported as they are.
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+
+		st := ToStatus(err)
+		if st.Code() == codes.Internal {
+			logger.Zap.Error("rpc failed", zap.Error(err), zap.String("method", info.FullMethod))
+		}
+
+		return nil, st.Err()
+	}
+}
+
+// recoverHandler calls the handler, turning a panic into an error.
+func recoverHandler(ctx context.Context, req any, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			resp, err = nil, errors.Errorf("panic: %v", r)
+		}
+	}()
+
+	return handler(ctx, req)
+}
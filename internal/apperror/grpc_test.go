@@ -0,0 +1,123 @@
+package apperror
+
+import (
+	"context"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	errNotFound := NotFound("wallet_not_found", "wallet not found")
+
+	tests := []struct {
+		name               string
+		handler            grpc.UnaryHandler
+		expectedCode       codes.Code
+		expectedMessage    string
+		expectedReason     string
+		expectedViolations []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			name:            "when domain error is returned then should report its code and message",
+			handler:         failWith(errors.Wrap(errNotFound, "repository")),
+			expectedCode:    codes.NotFound,
+			expectedMessage: "wallet not found",
+			expectedReason:  "wallet_not_found",
+		},
+		{
+			name:            "when resource already exists then should report already exists",
+			handler:         failWith(AlreadyExists("wallet_address_already_exists", "address is already registered to a wallet")),
+			expectedCode:    codes.AlreadyExists,
+			expectedMessage: "address is already registered to a wallet",
+			expectedReason:  "wallet_address_already_exists",
+		},
+		{
+			name:            "when state forbids the call then should report failed precondition",
+			handler:         failWith(Conflict("wallet_frozen", "wallet is frozen")),
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: "wallet is frozen",
+			expectedReason:  "wallet_frozen",
+		},
+		{
+			name: "when validation fails then should report per-field violations",
+			handler: failWith(InvalidRequest(errors.Wrap(validation.Errors{
+				"owner_id": errors.New("cannot be blank"),
+				"address":  errors.New("cannot be blank"),
+			}, "wallet create validation error"))),
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "invalid request",
+			expectedReason:  "invalid_request",
+			expectedViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "address", Description: "cannot be blank"},
+				{Field: "owner_id", Description: "cannot be blank"},
+			},
+		},
+		{
+			name:            "when unexpected error is returned then should hide its message",
+			handler:         failWith(errors.New(`pq: relation "wallets" does not exist`)),
+			expectedCode:    codes.Internal,
+			expectedMessage: "an unexpected error occurred",
+			expectedReason:  "internal_server_error",
+		},
+		{
+			name: "when handler panics then should report internal error",
+			handler: func(context.Context, any) (any, error) {
+				panic("boom")
+			},
+			expectedCode:    codes.Internal,
+			expectedMessage: "an unexpected error occurred",
+			expectedReason:  "internal_server_error",
+		},
+		{
+			name:            "when status is returned then should report it as it is",
+			handler:         failWith(status.Error(codes.Unimplemented, "method not implemented")),
+			expectedCode:    codes.Unimplemented,
+			expectedMessage: "method not implemented",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := &grpc.UnaryServerInfo{FullMethod: "/wallet.v1.WalletService/GetWallet"}
+
+			resp, err := UnaryServerInterceptor()(context.Background(), nil, info, tt.handler)
+
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, st.Code())
+			assert.Equal(t, tt.expectedMessage, st.Message())
+
+			var reason string
+			var violations []*errdetails.BadRequest_FieldViolation
+			for _, detail := range st.Details() {
+				switch detail := detail.(type) {
+				case *errdetails.ErrorInfo:
+					reason = detail.Reason
+					assert.Equal(t, ErrorDomain, detail.Domain)
+				case *errdetails.BadRequest:
+					violations = detail.FieldViolations
+				}
+			}
+			assert.Equal(t, tt.expectedReason, reason)
+			assert.Equal(t, len(tt.expectedViolations), len(violations))
+			for i, violation := range violations {
+				assert.Equal(t, tt.expectedViolations[i].Field, violation.Field)
+				assert.Equal(t, tt.expectedViolations[i].Description, violation.Description)
+			}
+		})
+	}
+}
+
+func failWith(err error) grpc.UnaryHandler {
+	return func(context.Context, any) (any, error) {
+		return nil, err
+	}
+}
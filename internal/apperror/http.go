@@ -0,0 +1,130 @@
+package apperror
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// Problem is an RFC 7807 problem details object extended with a stable error code,
+// the request correlation id and per-field validation errors.
+type Problem struct {
+	Type          string            `json:"type"`
+	Title         string            `json:"title"`
+	Status        int               `json:"status"`
+	Detail        string            `json:"detail,omitempty"`
+	Instance      string            `json:"instance,omitempty"`
+	Code          string            `json:"code"`
+	CorrelationID string            `json:"correlation_id,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty"`
+}
+
+var statusByKind = map[Kind]int{
+	KindValidation:           http.StatusBadRequest,
+	KindNotFound:             http.StatusNotFound,
+	KindAlreadyExists:        http.StatusConflict,
+	KindConflict:             http.StatusConflict,
+	KindForbidden:            http.StatusForbidden,
+	KindPreconditionFailed:   http.StatusPreconditionFailed,
+	KindPreconditionRequired: http.StatusPreconditionRequired,
+	KindUnprocessable:        http.StatusUnprocessableEntity,
+	KindInternal:             http.StatusInternalServerError,
+}
+
+// StatusOf returns the HTTP status code the error is reported with.
+func StatusOf(err error) int {
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code
+	}
+
+	return statusByKind[KindOf(err)]
+}
+
+// NewHTTPErrorHandler returns an echo error handler that renders every error as
+// application/problem+json. Messages of unexpected errors never reach the client;
+// they are logged together with the correlation id instead.
+func NewHTTPErrorHandler() echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		if c.Response().Committed {
+			return
+		}
+
+		problem := ToProblem(err)
+		problem.Instance = c.Request().URL.Path
+		problem.CorrelationID = correlationID(c)
+
+		if problem.Status >= http.StatusInternalServerError {
+			logger.Zap.Error("request failed",
+				zap.Error(err),
+				zap.String("correlation_id", problem.CorrelationID),
+				zap.String("method", c.Request().Method),
+				zap.String("path", problem.Instance),
+			)
+		}
+
+		if c.Request().Method == http.MethodHead {
+			_ = c.NoContent(problem.Status)
+			return
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, MIMEApplicationProblemJSON)
+		_ = c.JSON(problem.Status, problem)
+	}
+}
+
+// ToProblem converts an error into problem details without request specific fields.
+func ToProblem(err error) Problem {
+	status := StatusOf(err)
+	problem := Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Code:   codeFromStatus(status),
+	}
+
+	var appErr *Error
+	var httpErr *echo.HTTPError
+	switch {
+	case errors.As(err, &appErr) && status < http.StatusInternalServerError:
+		problem.Code = appErr.Code
+		problem.Detail = appErr.Message
+		problem.Errors = appErr.Fields
+		if appErr.Kind == KindValidation && len(appErr.Fields) == 0 && appErr.cause != nil {
+			// Without field details the cause is the only hint of what is wrong with the request.
+			problem.Detail = appErr.Error()
+		}
+	case errors.As(err, &httpErr) && status < http.StatusInternalServerError:
+		if message, ok := httpErr.Message.(string); ok {
+			problem.Detail = message
+		}
+	default:
+		problem.Detail = "an unexpected error occurred"
+	}
+
+	return problem
+}
+
+func correlationID(c echo.Context) string {
+	if id := c.Response().Header().Get(echo.HeaderXRequestID); id != "" {
+		return id
+	}
+
+	return c.Request().Header.Get(echo.HeaderXRequestID)
+}
+
+// codeFromStatus derives a snake_case code such as "not_found" from a status code.
+func codeFromStatus(status int) string {
+	text := http.StatusText(status)
+	if text == "" {
+		return "error"
+	}
+
+	return strings.ReplaceAll(strings.ToLower(text), " ", "_")
+}
@@ -0,0 +1,96 @@
+package apperror
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewHTTPErrorHandler(t *testing.T) {
+	errNotFound := NotFound("wallet_not_found", "wallet not found")
+
+	tests := []struct {
+		name            string
+		err             error
+		expectedProblem Problem
+	}{
+		{
+			name: "when domain error is returned then should render its code and message",
+			err:  errors.Wrap(errNotFound, "repository"),
+			expectedProblem: Problem{
+				Type: "about:blank", Title: "Not Found", Status: http.StatusNotFound,
+				Detail: "wallet not found", Code: "wallet_not_found",
+			},
+		},
+		{
+			name: "when validation fails then should render per-field errors",
+			err: InvalidRequest(errors.Wrap(validation.Errors{
+				"address": errors.New("cannot be blank"),
+			}, "wallet create validation error")),
+			expectedProblem: Problem{
+				Type: "about:blank", Title: "Bad Request", Status: http.StatusBadRequest,
+				Detail: "invalid request", Code: "invalid_request",
+				Errors: map[string]string{"address": "cannot be blank"},
+			},
+		},
+		{
+			name: "when request cannot be bound then should render the binding error",
+			err:  InvalidRequest(echo.NewHTTPError(http.StatusBadRequest, "Unmarshal type error")),
+			expectedProblem: Problem{
+				Type: "about:blank", Title: "Bad Request", Status: http.StatusBadRequest,
+				Detail: "invalid request: Unmarshal type error", Code: "invalid_request",
+			},
+		},
+		{
+			name: "when echo error is returned then should derive the code from the status",
+			err:  echo.ErrMethodNotAllowed,
+			expectedProblem: Problem{
+				Type: "about:blank", Title: "Method Not Allowed", Status: http.StatusMethodNotAllowed,
+				Detail: "Method Not Allowed", Code: "method_not_allowed",
+			},
+		},
+		{
+			name: "when unexpected error is returned then should hide its message",
+			err:  errors.New(`pq: relation "wallets" does not exist`),
+			expectedProblem: Problem{
+				Type: "about:blank", Title: "Internal Server Error", Status: http.StatusInternalServerError,
+				Detail: "an unexpected error occurred", Code: "internal_server_error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/wallets/1", nil)
+			req.Header.Set(echo.HeaderXRequestID, "correlation-id")
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			NewHTTPErrorHandler()(tt.err, ctx)
+
+			var problem Problem
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+
+			tt.expectedProblem.Instance = "/wallets/1"
+			tt.expectedProblem.CorrelationID = "correlation-id"
+			assert.Equal(t, tt.expectedProblem, problem)
+			assert.Equal(t, tt.expectedProblem.Status, rec.Code)
+			assert.Equal(t, MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+		})
+	}
+}
+
+func TestError_Is(t *testing.T) {
+	errConflict := Conflict("wallet_already_exists", "wallet already exists")
+
+	assert.ErrorIs(t, errConflict.Wrap(errors.New("duplicate key")), errConflict)
+	assert.ErrorIs(t, errors.Wrap(errConflict, "create"), errConflict)
+	assert.NotErrorIs(t, Conflict("wallet_not_deleted", "wallet is not deleted"), errConflict)
+}
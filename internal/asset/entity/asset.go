@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+// Asset is a coin or token of a network. Code is the asset code used by the ledger, and
+// amounts of the asset are kept there in base units, i.e. scaled by 10^Decimals.
+type Asset struct {
+	Code      string      `json:"code" gorm:"primaryKey"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt null.Time   `json:"updated_at"`
+	Symbol    string      `json:"symbol"`
+	Network   string      `json:"network"`
+	Contract  null.String `json:"contract"`
+	Decimals  int         `json:"decimals"`
+	Enabled   bool        `json:"enabled"`
+}
+
+// AssetChanges holds the mutable fields of an asset; nil fields are left untouched. The
+// decimals of an asset never change, as the ledger already holds amounts scaled by them.
+type AssetChanges struct {
+	Symbol  *string
+	Enabled *bool
+}
+
+// AssetFilter narrows down an asset listing.
+type AssetFilter struct {
+	Network string
+	Enabled *bool
+}
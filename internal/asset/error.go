@@ -0,0 +1,11 @@
+package asset
+
+import "github.com/safayildirim/wallet-management-service/internal/apperror"
+
+var (
+	ErrAssetNotFound  = apperror.NotFound("asset_not_found", "asset not found")
+	ErrDuplicateAsset = apperror.AlreadyExists("asset_already_exists", "asset already exists")
+	ErrUnknownNetwork = apperror.Validation("unknown_network", "unknown network")
+	ErrUnknownAsset   = apperror.Validation("unknown_asset", "unknown asset")
+	ErrAssetDisabled  = apperror.Validation("asset_disabled", "asset is disabled")
+)
@@ -0,0 +1,123 @@
+package asset
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/asset/request"
+	"net/http"
+)
+
+type Handler struct {
+	assetService Service
+}
+
+func NewHandler(assetService Service) *Handler {
+	return &Handler{assetService: assetService}
+}
+
+func (h Handler) RegisterRoutes(e *echo.Group) {
+	e.POST("/assets", h.CreateAsset)
+	e.GET("/assets", h.ListAssets)
+	e.GET("/assets/:code", h.GetAsset)
+	e.PATCH("/assets/:code", h.UpdateAsset)
+}
+
+// CreateAsset handles the registration of a new asset.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 201 Created with the created asset on success.
+//   - 400 Bad Request if the request payload is invalid or the network is not registered.
+//   - 403 Forbidden if the caller is scoped to an owner.
+//   - 409 Conflict if an asset with the same code or contract exists.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) CreateAsset(ctx echo.Context) error {
+	var req request.CreateAssetRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	asset, err := h.assetService.CreateAsset(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, Response{Data: asset})
+}
+
+// ListAssets returns the registered assets, optionally filtered.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the matching assets on success.
+//   - 400 Bad Request if the query parameters are invalid.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) ListAssets(ctx echo.Context) error {
+	var req request.ListAssetsRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	assets, err := h.assetService.ListAssets(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: assets})
+}
+
+// GetAsset retrieves an asset by its code.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the asset on success.
+//   - 404 Not Found if the asset does not exist.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) GetAsset(ctx echo.Context) error {
+	asset, err := h.assetService.GetAsset(ctx.Request().Context(), ctx.Param("code"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: asset})
+}
+
+// UpdateAsset partially updates an asset. Assets are never deleted, as the ledger refers to
+// them; they are disabled instead.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the updated asset on success.
+//   - 400 Bad Request if the request payload is invalid.
+//   - 403 Forbidden if the caller is scoped to an owner.
+//   - 404 Not Found if the asset does not exist.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) UpdateAsset(ctx echo.Context) error {
+	var req request.UpdateAssetRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	asset, err := h.assetService.UpdateAsset(ctx.Request().Context(), ctx.Param("code"), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: asset})
+}
@@ -0,0 +1,184 @@
+package asset
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/asset/entity"
+	assetmock "github.com/safayildirim/wallet-management-service/internal/asset/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_CreateAsset(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		body                 string
+		mockService          bool
+		mockReturn           *entity.Asset
+		mockError            error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:        "when valid request body is provided then should create asset",
+			body:        `{"code":"USDT.TRON","symbol":"USDT","network":"tron","contract":"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t","decimals":6}`,
+			mockService: true,
+			mockReturn: &entity.Asset{
+				Code: "USDT.TRON", Symbol: "USDT", Network: "tron", Decimals: 6, Enabled: true,
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "when asset has no decimals then should create asset",
+			body:           `{"code":"XYZ","symbol":"XYZ","network":"ethereum","contract":"0x01","decimals":0}`,
+			mockService:    true,
+			mockReturn:     &entity.Asset{Code: "XYZ", Symbol: "XYZ", Network: "ethereum", Enabled: true},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:                 "when decimals are missing then should return bad request",
+			body:                 `{"code":"BTC","symbol":"BTC","network":"bitcoin"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "decimals: is required",
+		},
+		{
+			name:                 "when decimals exceed maximum then should return bad request",
+			body:                 `{"code":"BTC","symbol":"BTC","network":"bitcoin","decimals":37}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "decimals: must be no greater than 36",
+		},
+		{
+			name:                 "when code has invalid characters then should return bad request",
+			body:                 `{"code":"US DT","symbol":"USDT","network":"tron","decimals":6}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "code: must be in a valid format",
+		},
+		{
+			name:                 "when asset already exists then should return conflict",
+			body:                 `{"code":"BTC","symbol":"BTC","network":"bitcoin","decimals":8}`,
+			mockService:          true,
+			mockError:            ErrDuplicateAsset,
+			expectedStatus:       http.StatusConflict,
+			expectErr:            true,
+			expectedErrorMessage: "asset already exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := assetmock.NewMockAssetService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().CreateAsset(mock.Anything, mock.Anything).
+					Return(tt.mockReturn, tt.mockError).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/assets", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.CreateAsset(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_UpdateAsset(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		body                 string
+		mockService          bool
+		mockReturn           *entity.Asset
+		mockError            error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when asset is disabled then should return updated asset",
+			body:           `{"enabled":false}`,
+			mockService:    true,
+			mockReturn:     &entity.Asset{Code: "XRP", Network: "xrp", Decimals: 6},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when decimals are changed then should return bad request",
+			body:                 `{"decimals":8}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "at least one field must be provided",
+		},
+		{
+			name:                 "when asset does not exist then should return not found",
+			body:                 `{"symbol":"XRP"}`,
+			mockService:          true,
+			mockError:            ErrAssetNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "asset not found",
+		},
+		{
+			name:                 "when service returns error then should return internal server error",
+			body:                 `{"symbol":"XRP"}`,
+			mockService:          true,
+			mockError:            errors.New("service error"),
+			expectedStatus:       http.StatusInternalServerError,
+			expectErr:            true,
+			expectedErrorMessage: "service error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := assetmock.NewMockAssetService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().UpdateAsset(mock.Anything, "XRP", mock.Anything).
+					Return(tt.mockReturn, tt.mockError).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/assets/:code", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/assets/:code")
+			ctx.SetParamNames("code")
+			ctx.SetParamValues("XRP")
+
+			err := handler.UpdateAsset(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package asset
+
+type Response struct {
+	Data any `json:"data"`
+}
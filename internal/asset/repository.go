@@ -0,0 +1,102 @@
+package asset
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/asset/entity"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
+	"time"
+)
+
+type Repository interface {
+	CreateAsset(ctx context.Context, entity *entity.Asset) (*entity.Asset, error)
+	GetAsset(ctx context.Context, code string) (*entity.Asset, error)
+	ListAssets(ctx context.Context, filter entity.AssetFilter) ([]*entity.Asset, error)
+	UpdateAsset(ctx context.Context, code string, changes entity.AssetChanges) (*entity.Asset, error)
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+// CreateAsset registers an asset; both its code and its contract on the network must be unused.
+func (r *repository) CreateAsset(ctx context.Context, entity *entity.Asset) (*entity.Asset, error) {
+	err := r.db.WithContext(ctx).Create(entity).Error
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, ErrDuplicateAsset
+		}
+		if strings.Contains(err.Error(), "foreign key") {
+			return nil, ErrUnknownNetwork.WithFields(map[string]string{"network": "is not registered"})
+		}
+
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (r *repository) GetAsset(ctx context.Context, code string) (*entity.Asset, error) {
+	var item entity.Asset
+	err := r.db.WithContext(ctx).Where("code = ?", code).First(&item).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAssetNotFound
+		}
+
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (r *repository) ListAssets(ctx context.Context, filter entity.AssetFilter) ([]*entity.Asset, error) {
+	query := r.db.WithContext(ctx).Model(&entity.Asset{})
+
+	if filter.Network != "" {
+		query = query.Where("network = ?", filter.Network)
+	}
+	if filter.Enabled != nil {
+		query = query.Where("enabled = ?", *filter.Enabled)
+	}
+
+	var items []*entity.Asset
+	err := query.Order("code").Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *repository) UpdateAsset(ctx context.Context, code string, changes entity.AssetChanges) (*entity.Asset, error) {
+	updates := map[string]interface{}{
+		"updated_at": time.Now(),
+	}
+	if changes.Symbol != nil {
+		updates["symbol"] = *changes.Symbol
+	}
+	if changes.Enabled != nil {
+		updates["enabled"] = *changes.Enabled
+	}
+
+	var item entity.Asset
+	result := r.db.WithContext(ctx).Model(&item).Clauses(clause.Returning{}).
+		Where("code = ?", code).
+		Updates(updates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, ErrAssetNotFound
+	}
+
+	return &item, nil
+}
@@ -0,0 +1,57 @@
+package request
+
+import (
+	"regexp"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+)
+
+// MaxDecimals is the largest number of decimals an asset may have, leaving room for
+// amounts of many whole units within the digits of an amount.
+const MaxDecimals = 36
+
+var codePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,31}$`)
+
+type CreateAssetRequest struct {
+	Code     string  `json:"code"`
+	Symbol   string  `json:"symbol"`
+	Network  string  `json:"network"`
+	Contract *string `json:"contract"`
+	Decimals *int    `json:"decimals"`
+	Enabled  *bool   `json:"enabled"`
+}
+
+func (r CreateAssetRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Code, validation.Required, validation.Match(codePattern)),
+		validation.Field(&r.Symbol, validation.Required, validation.Length(1, 16)),
+		validation.Field(&r.Network, validation.Required),
+		validation.Field(&r.Contract, validation.Length(1, 128)),
+		validation.Field(&r.Decimals, validation.NotNil, validation.Min(0), validation.Max(MaxDecimals)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "asset create validation error")
+}
+
+type UpdateAssetRequest struct {
+	Symbol  *string `json:"symbol"`
+	Enabled *bool   `json:"enabled"`
+}
+
+func (r UpdateAssetRequest) Validate() error {
+	if r.Symbol == nil && r.Enabled == nil {
+		return errors.New("asset update validation error: at least one field must be provided")
+	}
+
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Symbol, validation.Length(1, 16)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "asset update validation error")
+}
+
+type ListAssetsRequest struct {
+	Network string `json:"network" query:"network"`
+	Enabled *bool  `json:"enabled" query:"enabled"`
+}
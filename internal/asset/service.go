@@ -0,0 +1,156 @@
+package asset
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/asset/entity"
+	"github.com/safayildirim/wallet-management-service/internal/asset/request"
+	"github.com/safayildirim/wallet-management-service/internal/auth"
+	"github.com/safayildirim/wallet-management-service/internal/network"
+	"gopkg.in/guregu/null.v3"
+	"strings"
+)
+
+type Service interface {
+	CreateAsset(ctx context.Context, request *request.CreateAssetRequest) (*entity.Asset, error)
+	GetAsset(ctx context.Context, code string) (*entity.Asset, error)
+	ListAssets(ctx context.Context, request *request.ListAssetsRequest) ([]*entity.Asset, error)
+	UpdateAsset(ctx context.Context, code string, request *request.UpdateAssetRequest) (*entity.Asset, error)
+	ResolveAsset(ctx context.Context, code string) (*entity.Asset, error)
+}
+
+type service struct {
+	assetRepository Repository
+	networkService  network.Service
+}
+
+func NewService(assetRepository Repository, networkService network.Service) Service {
+	return &service{assetRepository: assetRepository, networkService: networkService}
+}
+
+// CreateAsset registers a new asset on a network.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - request: Request object containing the asset details.
+//
+// Returns:
+//   - The created asset entity.
+//   - An error if the caller is scoped to an owner, the network is not registered, the code or the
+//     contract is taken or creation fails.
+func (s *service) CreateAsset(ctx context.Context, request *request.CreateAssetRequest) (*entity.Asset, error) {
+	if err := auth.RequireUnscoped(ctx); err != nil {
+		return nil, err
+	}
+
+	net, err := s.networkService.GetNetwork(ctx, request.Network)
+	if err != nil {
+		if errors.Is(err, network.ErrNetworkNotFound) {
+			return nil, ErrUnknownNetwork.WithFields(map[string]string{"network": "is not registered"})
+		}
+
+		return nil, err
+	}
+
+	item := entity.Asset{
+		Code:     NormalizeCode(request.Code),
+		Symbol:   strings.TrimSpace(request.Symbol),
+		Network:  net.Code,
+		Decimals: *request.Decimals,
+		Enabled:  true,
+	}
+	if request.Contract != nil {
+		// Native coins of a network have no contract
+		contract := strings.TrimSpace(*request.Contract)
+		item.Contract = null.NewString(contract, contract != "")
+	}
+	if request.Enabled != nil {
+		item.Enabled = *request.Enabled
+	}
+
+	return s.assetRepository.CreateAsset(ctx, &item)
+}
+
+// GetAsset retrieves an asset by its code.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - code: The asset code, matched case-insensitively.
+//
+// Returns:
+//   - The asset entity if found.
+//   - An error if the asset does not exist or retrieval fails.
+func (s *service) GetAsset(ctx context.Context, code string) (*entity.Asset, error) {
+	return s.assetRepository.GetAsset(ctx, NormalizeCode(code))
+}
+
+// ListAssets returns all assets matching the request filters, ordered by code.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - request: Request object containing the network and enabled filters.
+//
+// Returns:
+//   - The matching assets.
+//   - An error if retrieval fails.
+func (s *service) ListAssets(ctx context.Context, request *request.ListAssetsRequest) ([]*entity.Asset, error) {
+	return s.assetRepository.ListAssets(ctx, entity.AssetFilter{
+		Network: network.NormalizeCode(request.Network),
+		Enabled: request.Enabled,
+	})
+}
+
+// UpdateAsset partially updates an asset, e.g. to disable it.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - code: The asset code.
+//   - request: Request object containing the fields to change.
+//
+// Returns:
+//   - The updated asset entity.
+//   - An error if the caller is scoped to an owner, the asset does not exist or the update fails.
+func (s *service) UpdateAsset(ctx context.Context, code string, request *request.UpdateAssetRequest) (*entity.Asset, error) {
+	if err := auth.RequireUnscoped(ctx); err != nil {
+		return nil, err
+	}
+
+	changes := entity.AssetChanges{Enabled: request.Enabled}
+	if request.Symbol != nil {
+		symbol := strings.TrimSpace(*request.Symbol)
+		changes.Symbol = &symbol
+	}
+
+	return s.assetRepository.UpdateAsset(ctx, NormalizeCode(code), changes)
+}
+
+// ResolveAsset retrieves an asset that amounts may currently be given in.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - code: The asset code, matched case-insensitively.
+//
+// Returns:
+//   - The asset entity.
+//   - An error if the asset is not registered or is disabled, or retrieval fails.
+func (s *service) ResolveAsset(ctx context.Context, code string) (*entity.Asset, error) {
+	item, err := s.assetRepository.GetAsset(ctx, NormalizeCode(code))
+	if err != nil {
+		if errors.Is(err, ErrAssetNotFound) {
+			return nil, ErrUnknownAsset.WithFields(map[string]string{"asset": "is not registered"})
+		}
+
+		return nil, err
+	}
+
+	if !item.Enabled {
+		return nil, ErrAssetDisabled.WithFields(map[string]string{"asset": "is disabled"})
+	}
+
+	return item, nil
+}
+
+// NormalizeCode returns the canonical, uppercase form of an asset code.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,159 @@
+package asset
+
+import (
+	"context"
+	"github.com/safayildirim/wallet-management-service/internal/asset/entity"
+	assetmock "github.com/safayildirim/wallet-management-service/internal/asset/mock"
+	"github.com/safayildirim/wallet-management-service/internal/asset/request"
+	"github.com/safayildirim/wallet-management-service/internal/auth"
+	"github.com/safayildirim/wallet-management-service/internal/network"
+	networkentity "github.com/safayildirim/wallet-management-service/internal/network/entity"
+	networkmock "github.com/safayildirim/wallet-management-service/internal/network/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v3"
+	"testing"
+)
+
+func TestService_CreateAsset(t *testing.T) {
+	six := 6
+	eighteen := 18
+	contract := " 0xdAC17F958D2ee523a2206206994597C13D831ec7 "
+	disabled := false
+
+	tests := []struct {
+		name           string
+		request        *request.CreateAssetRequest
+		networkErr     error
+		mockRepository bool
+		expectedEntity *entity.Asset
+		expectedError  error
+	}{
+		{
+			name: "when token is valid then should create enabled asset",
+			request: &request.CreateAssetRequest{
+				Code: "usdt.ethereum", Symbol: "USDT", Network: "Ethereum", Contract: &contract, Decimals: &six,
+			},
+			mockRepository: true,
+			expectedEntity: &entity.Asset{
+				Code: "USDT.ETHEREUM", Symbol: "USDT", Network: "ethereum",
+				Contract: null.StringFrom("0xdAC17F958D2ee523a2206206994597C13D831ec7"), Decimals: 6, Enabled: true,
+			},
+		},
+		{
+			name: "when native coin is created disabled then should keep it disabled",
+			request: &request.CreateAssetRequest{
+				Code: "ETH", Symbol: "ETH", Network: "ethereum", Decimals: &eighteen, Enabled: &disabled,
+			},
+			mockRepository: true,
+			expectedEntity: &entity.Asset{Code: "ETH", Symbol: "ETH", Network: "ethereum", Decimals: 18},
+		},
+		{
+			name: "when network is not registered then should return error",
+			request: &request.CreateAssetRequest{
+				Code: "DOGE", Symbol: "DOGE", Network: "dogecoin", Decimals: &eighteen,
+			},
+			networkErr:    network.ErrNetworkNotFound,
+			expectedError: ErrUnknownNetwork,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := assetmock.NewMockAssetRepository(t)
+			mockNetworkService := networkmock.NewMockNetworkService(t)
+			s := NewService(mockRepository, mockNetworkService)
+
+			var mockNetwork *networkentity.Network
+			if tt.networkErr == nil {
+				mockNetwork = &networkentity.Network{Code: "ethereum", Enabled: true}
+			}
+			mockNetworkService.EXPECT().GetNetwork(mock.Anything, tt.request.Network).Return(mockNetwork, tt.networkErr).Once()
+			if tt.mockRepository {
+				mockRepository.EXPECT().CreateAsset(mock.Anything, tt.expectedEntity).
+					Return(tt.expectedEntity, nil).Once()
+			}
+
+			result, err := s.CreateAsset(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedEntity, result)
+			}
+		})
+	}
+}
+
+func TestService_UpdateAsset(t *testing.T) {
+	mockRepository := assetmock.NewMockAssetRepository(t)
+	s := NewService(mockRepository, networkmock.NewMockNetworkService(t))
+
+	symbol := " USD₮ "
+	trimmed := "USD₮"
+	expected := &entity.Asset{Code: "USDT.TRON", Symbol: "USD₮"}
+	mockRepository.EXPECT().UpdateAsset(mock.Anything, "USDT.TRON", entity.AssetChanges{Symbol: &trimmed}).
+		Return(expected, nil).Once()
+
+	result, err := s.UpdateAsset(context.Background(), "usdt.tron", &request.UpdateAssetRequest{Symbol: &symbol})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestService_ResolveAsset(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockReturn    *entity.Asset
+		mockError     error
+		expectedError error
+	}{
+		{
+			name:       "when asset is enabled then should return it",
+			mockReturn: &entity.Asset{Code: "BTC", Decimals: 8, Enabled: true},
+		},
+		{
+			name:          "when asset is disabled then should return error",
+			mockReturn:    &entity.Asset{Code: "BTC", Decimals: 8},
+			expectedError: ErrAssetDisabled,
+		},
+		{
+			name:          "when asset does not exist then should return error",
+			mockError:     ErrAssetNotFound,
+			expectedError: ErrUnknownAsset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := assetmock.NewMockAssetRepository(t)
+			s := NewService(mockRepository, networkmock.NewMockNetworkService(t))
+
+			mockRepository.EXPECT().GetAsset(mock.Anything, "BTC").Return(tt.mockReturn, tt.mockError).Once()
+
+			result, err := s.ResolveAsset(context.Background(), " btc")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockReturn, result)
+			}
+		})
+	}
+}
+
+func TestService_ScopedCaller(t *testing.T) {
+	mockRepository := assetmock.NewMockAssetRepository(t)
+	s := NewService(mockRepository, networkmock.NewMockNetworkService(t))
+	ctx := auth.WithScope(context.Background(), auth.Scope{OwnerID: "customer-1"})
+	decimals := 6
+	enabled := false
+
+	_, err := s.CreateAsset(ctx, &request.CreateAssetRequest{Code: "USDT.TRON", Symbol: "USDT", Network: "tron", Decimals: &decimals})
+	assert.ErrorIs(t, err, auth.ErrScopeForbidden)
+
+	_, err = s.UpdateAsset(ctx, "USDT.TRON", &request.UpdateAssetRequest{Enabled: &enabled})
+	assert.ErrorIs(t, err, auth.ErrScopeForbidden)
+}
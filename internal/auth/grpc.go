@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataOwnerID is the gRPC metadata key of the owner header; metadata keys are lowercase.
+var MetadataOwnerID = strings.ToLower(HeaderOwnerID)
+
+// UnaryServerInterceptor restricts calls carrying the owner metadata to that owner, the same
+// way Middleware does for HTTP requests.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(MetadataOwnerID); len(values) > 0 {
+				if ownerID := strings.TrimSpace(values[0]); ownerID != "" {
+					ctx = WithScope(ctx, Scope{OwnerID: ownerID})
+				}
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
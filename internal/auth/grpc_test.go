@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	tests := []struct {
+		name          string
+		metadata      metadata.MD
+		expectedScope Scope
+		expectScoped  bool
+	}{
+		{
+			name:          "when owner metadata is set then should scope call to owner",
+			metadata:      metadata.Pairs("X-Owner-ID", " customer-1 "),
+			expectedScope: Scope{OwnerID: "customer-1"},
+			expectScoped:  true,
+		},
+		{
+			name:         "when owner metadata is missing then should not scope call",
+			metadata:     metadata.MD{},
+			expectScoped: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.metadata)
+
+			var scope Scope
+			var scoped bool
+			_, err := UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				scope, scoped = ScopeFrom(ctx)
+				return nil, nil
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectScoped, scoped)
+			assert.Equal(t, tt.expectedScope, scope)
+		})
+	}
+}
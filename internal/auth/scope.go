@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+)
+
+// HeaderOwnerID carries the owner a caller is authorized for. It is set by the API
+// gateway after authenticating the caller and must never be accepted from clients directly.
+const HeaderOwnerID = "X-Owner-ID"
+
+var ErrScopeForbidden = apperror.Forbidden("scope_forbidden", "not authorized for resources shared by all owners")
+
+// Scope restricts a caller to the wallets of a single owner.
+type Scope struct {
+	OwnerID string
+}
+
+// Allows reports whether the scope grants access to resources of the given owner.
+func (s Scope) Allows(ownerID string) bool {
+	return s.OwnerID == ownerID
+}
+
+type scopeKey struct{}
+
+// WithScope returns a copy of ctx restricted to the given scope.
+func WithScope(ctx context.Context, scope Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// ScopeFrom returns the scope of the caller. Callers without a scope, such as
+// internal services and background workers, are not restricted.
+func ScopeFrom(ctx context.Context) (Scope, bool) {
+	scope, ok := ctx.Value(scopeKey{}).(Scope)
+	return scope, ok
+}
+
+// RequireUnscoped returns ErrScopeForbidden if the caller is scoped to an owner. Resources
+// shared by all owners, such as the network and asset registries, may only be changed by
+// internal callers.
+func RequireUnscoped(ctx context.Context) error {
+	if _, ok := ScopeFrom(ctx); ok {
+		return ErrScopeForbidden
+	}
+
+	return nil
+}
+
+// Middleware restricts requests carrying the owner header to that owner.
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if ownerID := strings.TrimSpace(c.Request().Header.Get(HeaderOwnerID)); ownerID != "" {
+				req := c.Request()
+				c.SetRequest(req.WithContext(WithScope(req.Context(), Scope{OwnerID: ownerID})))
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name          string
+		header        string
+		expectedScope Scope
+		expectScoped  bool
+	}{
+		{
+			name:          "when owner header is set then should scope request to owner",
+			header:        " customer-1 ",
+			expectedScope: Scope{OwnerID: "customer-1"},
+			expectScoped:  true,
+		},
+		{
+			name:         "when owner header is missing then should not scope request",
+			header:       "",
+			expectScoped: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/wallets", nil)
+			if tt.header != "" {
+				req.Header.Set(HeaderOwnerID, tt.header)
+			}
+			ctx := e.NewContext(req, httptest.NewRecorder())
+
+			var scope Scope
+			var scoped bool
+			err := Middleware()(func(c echo.Context) error {
+				scope, scoped = ScopeFrom(c.Request().Context())
+				return nil
+			})(ctx)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectScoped, scoped)
+			assert.Equal(t, tt.expectedScope, scope)
+		})
+	}
+}
+
+func TestRequireUnscoped(t *testing.T) {
+	assert.NoError(t, RequireUnscoped(context.Background()))
+	assert.ErrorIs(t, RequireUnscoped(WithScope(context.Background(), Scope{OwnerID: "customer-1"})), ErrScopeForbidden)
+}
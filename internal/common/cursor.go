@@ -0,0 +1,32 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// EncodeCursor serializes the given value into an opaque, URL-safe cursor string.
+func EncodeCursor(v any) (string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// DecodeCursor parses a cursor produced by EncodeCursor into v.
+func DecodeCursor(s string, v any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return errors.Wrap(err, "malformed cursor")
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return errors.Wrap(err, "malformed cursor")
+	}
+
+	return nil
+}
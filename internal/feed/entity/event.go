@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event is a wallet event as served by the feed. Its position orders the feed: positions are
+// handed out in the order the events become visible, so a reader that has seen an event has
+// seen every event before it as well.
+type Event struct {
+	Position      uint64          `json:"position"`
+	ID            uint            `json:"id"`
+	CreatedAt     time.Time       `json:"created_at"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	Type          string          `json:"type"`
+	Payload       json.RawMessage `json:"data" gorm:"serializer:json"`
+}
+
+func (Event) TableName() string {
+	return "outbox"
+}
+
+// EventFilter describes a single page of the feed.
+type EventFilter struct {
+	AfterPosition uint64
+	OwnerID       *string
+	Limit         int
+}
@@ -0,0 +1,7 @@
+package feed
+
+import "github.com/safayildirim/wallet-management-service/internal/apperror"
+
+var (
+	ErrInvalidCursor = apperror.Validation("invalid_cursor", "invalid cursor")
+)
@@ -0,0 +1,167 @@
+package feed
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/feed/entity"
+	"github.com/safayildirim/wallet-management-service/internal/feed/request"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"net/http"
+)
+
+const (
+	MIMETextEventStream = "text/event-stream"
+	HeaderLastEventID   = "Last-Event-ID"
+)
+
+type Handler struct {
+	feedService Service
+	// heartbeatWait is the number of seconds a stream waits for events before it sends a
+	// heartbeat, which keeps idle connections from being dropped by proxies.
+	heartbeatWait int
+	// shutdown is cancelled when the server shuts down, ending waiting requests and streams
+	// that would otherwise hold the shutdown up.
+	shutdown       context.Context
+	cancelShutdown context.CancelFunc
+}
+
+func NewHandler(feedService Service, conf config.EventFeedConfig) *Handler {
+	shutdown, cancelShutdown := context.WithCancel(context.Background())
+
+	return &Handler{
+		feedService:    feedService,
+		heartbeatWait:  max(1, int(conf.HeartbeatInterval.Seconds())),
+		shutdown:       shutdown,
+		cancelShutdown: cancelShutdown,
+	}
+}
+
+func (h Handler) RegisterRoutes(e *echo.Group) {
+	e.GET("/events", h.ListEvents)
+	e.GET("/events/stream", h.StreamEvents)
+}
+
+// Shutdown ends the requests waiting for events and the open streams.
+func (h Handler) Shutdown() {
+	h.cancelShutdown()
+}
+
+// ListEvents retrieves the wallet events after a cursor, waiting for new ones if asked to.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the events and the cursor to continue from on success.
+//   - 400 Bad Request if the query parameters or the cursor are invalid.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) ListEvents(ctx echo.Context) error {
+	var req request.ListEventsRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	reqCtx, cancel := h.context(ctx)
+	defer cancel()
+
+	events, next, err := h.feedService.ListEvents(reqCtx, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: events, NextCursor: next})
+}
+
+// StreamEvents streams the wallet events after a cursor as Server-Sent Events until the client
+// disconnects. The ID of every message is the cursor to resume from after it.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the stream of events on success.
+//   - 400 Bad Request if the cursor is invalid.
+//   - 500 Internal Server Error for unexpected issues. Errors raised once streaming has
+//     started cut the stream short instead.
+func (h Handler) StreamEvents(ctx echo.Context) error {
+	var req request.StreamEventsRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+	req.LastEventID = ctx.Request().Header.Get(HeaderLastEventID)
+
+	reqCtx, cancel := h.context(ctx)
+	defer cancel()
+
+	// The first page is read before the stream starts, so that an invalid cursor is still
+	// reported as usual.
+	events, next, err := h.feedService.ListEvents(reqCtx, &request.ListEventsRequest{After: req.Cursor()})
+	if err != nil {
+		return err
+	}
+
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, MIMETextEventStream)
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	for {
+		if err := writeEvents(res, events); err != nil {
+			return err
+		}
+		res.Flush()
+
+		events, next, err = h.feedService.ListEvents(reqCtx, &request.ListEventsRequest{After: next, Wait: h.heartbeatWait})
+		if reqCtx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// context returns the context of a request, cancelled as well when the server shuts down.
+func (h Handler) context(ctx echo.Context) (context.Context, context.CancelFunc) {
+	reqCtx, cancel := context.WithCancel(ctx.Request().Context())
+	stop := context.AfterFunc(h.shutdown, cancel)
+
+	return reqCtx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// writeEvents writes the events as Server-Sent Events, or a heartbeat comment if there are none.
+func writeEvents(res *echo.Response, events []*entity.Event) error {
+	if len(events) == 0 {
+		_, err := fmt.Fprint(res, ": heartbeat\n\n")
+		return err
+	}
+
+	for _, event := range events {
+		id, err := encodeCursor(event.Position)
+		if err != nil {
+			return err
+		}
+
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(res, "id: %s\nevent: %s\ndata: %s\n\n", id, event.Type, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package feed
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/feed/entity"
+	feedmock "github.com/safayildirim/wallet-management-service/internal/feed/mock"
+	"github.com/safayildirim/wallet-management-service/internal/feed/request"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandler_ListEvents(t *testing.T) {
+	e := echo.New()
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                 string
+		query                string
+		mockService          bool
+		mockReturnData       []*entity.Event
+		mockReturnCursor     string
+		mockReturnErr        error
+		expectedRequest      *request.ListEventsRequest
+		expectedStatus       int
+		expectedBody         string
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:        "when there are events then should return them with the cursor to continue from",
+			query:       "?after=abc&limit=10&wait=30",
+			mockService: true,
+			mockReturnData: []*entity.Event{{
+				Position: 6, ID: 9, CreatedAt: createdAt, AggregateType: "wallet", AggregateID: "1",
+				Type: "WalletCreated", Payload: json.RawMessage(`{"id":1}`),
+			}},
+			mockReturnCursor: "def",
+			expectedRequest:  &request.ListEventsRequest{After: "abc", Limit: 10, Wait: 30},
+			expectedStatus:   http.StatusOK,
+			expectedBody: `{"data":[{"position":6,"id":9,"created_at":"2024-01-01T00:00:00Z","aggregate_type":"wallet",
+				"aggregate_id":"1","type":"WalletCreated","data":{"id":1}}],"next_cursor":"def"}`,
+		},
+		{
+			name:             "when there are no events then should return the cursor to continue from",
+			query:            "?after=abc",
+			mockService:      true,
+			mockReturnData:   []*entity.Event{},
+			mockReturnCursor: "abc",
+			expectedRequest:  &request.ListEventsRequest{After: "abc"},
+			expectedStatus:   http.StatusOK,
+			expectedBody:     `{"data":[],"next_cursor":"abc"}`,
+		},
+		{
+			name:                 "when wait is too long then should return bad request",
+			query:                "?wait=61",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "wait: must be no greater than 60",
+		},
+		{
+			name:                 "when cursor is invalid then should return bad request",
+			query:                "?after=abc",
+			mockService:          true,
+			mockReturnErr:        ErrInvalidCursor,
+			expectedRequest:      &request.ListEventsRequest{After: "abc"},
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "invalid cursor",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := feedmock.NewMockFeedService(t)
+			handler := NewHandler(mockService, config.EventFeedConfig{HeartbeatInterval: time.Second})
+
+			if tt.mockService {
+				mockService.EXPECT().ListEvents(mock.Anything, tt.expectedRequest).
+					Return(tt.mockReturnData, tt.mockReturnCursor, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/events"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.ListEvents(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_StreamEvents(t *testing.T) {
+	e := echo.New()
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	event := &entity.Event{
+		Position: 6, ID: 9, CreatedAt: createdAt, AggregateType: "wallet", AggregateID: "1",
+		Type: "WalletCreated", Payload: json.RawMessage(`{"id":1}`),
+	}
+	id, _ := encodeCursor(6)
+
+	tests := []struct {
+		name                 string
+		query                string
+		lastEventID          string
+		expectedCursor       string
+		firstErr             error
+		nextErr              error
+		expectedStatus       int
+		expectedBody         string
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when streaming then should write events and heartbeats until shutdown",
+			query:          "?after=abc",
+			expectedCursor: "abc",
+			expectedStatus: http.StatusOK,
+			expectedBody: ": heartbeat\n\n" +
+				"id: " + id + "\nevent: WalletCreated\ndata: " +
+				`{"position":6,"id":9,"created_at":"2024-01-01T00:00:00Z","aggregate_type":"wallet","aggregate_id":"1","type":"WalletCreated","data":{"id":1}}` +
+				"\n\n",
+		},
+		{
+			name:           "when client reconnects then should resume from the last event id",
+			query:          "?after=abc",
+			lastEventID:    "def",
+			expectedCursor: "def",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when cursor is invalid then should return bad request before streaming",
+			query:                "?after=abc",
+			expectedCursor:       "abc",
+			firstErr:             ErrInvalidCursor,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "invalid cursor",
+		},
+		{
+			name:                 "when reading fails while streaming then should cut the stream short",
+			query:                "?after=abc",
+			expectedCursor:       "abc",
+			nextErr:              errors.New("service error"),
+			expectedStatus:       http.StatusOK,
+			expectErr:            true,
+			expectedErrorMessage: "service error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := feedmock.NewMockFeedService(t)
+			handler := NewHandler(mockService, config.EventFeedConfig{HeartbeatInterval: 15 * time.Second})
+
+			if tt.firstErr != nil {
+				mockService.EXPECT().ListEvents(mock.Anything, &request.ListEventsRequest{After: tt.expectedCursor}).
+					Return(nil, "", tt.firstErr).Once()
+			} else {
+				mockService.EXPECT().ListEvents(mock.Anything, &request.ListEventsRequest{After: tt.expectedCursor}).
+					Return([]*entity.Event{}, tt.expectedCursor, nil).Once()
+				if tt.nextErr != nil {
+					mockService.EXPECT().ListEvents(mock.Anything, &request.ListEventsRequest{After: tt.expectedCursor, Wait: 15}).
+						Return(nil, "", tt.nextErr).Once()
+				} else {
+					mockService.EXPECT().ListEvents(mock.Anything, &request.ListEventsRequest{After: tt.expectedCursor, Wait: 15}).
+						Return([]*entity.Event{event}, id, nil).Once()
+					mockService.EXPECT().ListEvents(mock.Anything, &request.ListEventsRequest{After: id, Wait: 15}).
+						RunAndReturn(func(ctx context.Context, _ *request.ListEventsRequest) ([]*entity.Event, string, error) {
+							handler.Shutdown()
+							<-ctx.Done()
+							return []*entity.Event{}, id, nil
+						}).Once()
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/events/stream"+tt.query, nil)
+			if tt.lastEventID != "" {
+				req.Header.Set(HeaderLastEventID, tt.lastEventID)
+			}
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.StreamEvents(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				if tt.nextErr == nil {
+					assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+					assert.False(t, ctx.Response().Committed)
+				} else {
+					assert.Equal(t, tt.expectedStatus, rec.Code)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, MIMETextEventStream, rec.Header().Get(echo.HeaderContentType))
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package feed
+
+type Response struct {
+	Data       any    `json:"data"`
+	NextCursor string `json:"next_cursor"`
+}
@@ -0,0 +1,78 @@
+package feed
+
+import (
+	"context"
+	"github.com/safayildirim/wallet-management-service/internal/feed/entity"
+	"gorm.io/gorm"
+)
+
+type Repository interface {
+	SequenceEvents(ctx context.Context, limit int) (int64, error)
+	ListEvents(ctx context.Context, filter entity.EventFilter) ([]*entity.Event, error)
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+// SequenceEvents hands out the next feed positions to up to limit committed events that do not
+// have one yet, oldest first.
+//
+// Event IDs cannot order the feed: they are taken when an event is written, not when it is
+// committed, so an event may become visible after events with higher IDs and a reader that has
+// moved past them would miss it. Positions are only handed out to committed events, by one
+// caller at a time, so an event never becomes visible behind a position a reader has seen.
+func (r *repository) SequenceEvents(ctx context.Context, limit int) (int64, error) {
+	var sequenced int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext('event_feed_sequencer'))").Error; err != nil {
+			return err
+		}
+
+		result := tx.Exec(`
+			WITH last AS (
+				SELECT coalesce(max(position), 0) AS position FROM outbox
+			), pending AS (
+				SELECT id, row_number() OVER (ORDER BY id) AS n FROM outbox
+				WHERE position IS NULL
+				ORDER BY id
+				LIMIT ?
+			)
+			UPDATE outbox SET position = last.position + pending.n
+			FROM pending, last
+			WHERE outbox.id = pending.id`,
+			limit,
+		)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		sequenced = result.RowsAffected
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return sequenced, nil
+}
+
+// ListEvents returns a page of the feed, oldest first.
+func (r *repository) ListEvents(ctx context.Context, filter entity.EventFilter) ([]*entity.Event, error) {
+	query := r.db.WithContext(ctx).Where("position > ?", filter.AfterPosition)
+	if filter.OwnerID != nil {
+		query = query.Where("owner_id = ?", *filter.OwnerID)
+	}
+
+	items := make([]*entity.Event, 0)
+	if err := query.Order("position").Limit(filter.Limit).Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,31 @@
+package feed
+
+import (
+	"context"
+	"github.com/safayildirim/wallet-management-service/internal/feed/entity"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestListEvents(t *testing.T) {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	assert.NoError(t, err)
+
+	var sql string
+	err = db.Callback().Query().After("gorm:query").Register("test:record", func(tx *gorm.DB) {
+		sql = tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...)
+	})
+	assert.NoError(t, err)
+
+	ownerID := "owner-1"
+	_, err = NewRepository(db).ListEvents(context.Background(), entity.EventFilter{AfterPosition: 41, OwnerID: &ownerID, Limit: 100})
+
+	// The owner is matched on its column, which is indexed together with the position.
+	assert.NoError(t, err)
+	assert.Equal(t, `SELECT * FROM "outbox" WHERE position > 41 AND owner_id = 'owner-1' ORDER BY position LIMIT 100`, sql)
+}
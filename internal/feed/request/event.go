@@ -0,0 +1,48 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultListLimit = 100
+	MaxListLimit     = 1000
+
+	// MaxWait is the longest a long-polling request may wait for events, in seconds.
+	MaxWait = 60
+)
+
+// ListEventsRequest retrieves the events after a cursor, oldest first. Without a cursor the
+// feed is read from its start. With a wait, a request that finds no events waits up to that
+// many seconds for new ones before returning an empty page.
+type ListEventsRequest struct {
+	After string `json:"after" query:"after"`
+	Limit int    `json:"limit" query:"limit"`
+	Wait  int    `json:"wait" query:"wait"`
+}
+
+func (r ListEventsRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Limit, validation.Min(0), validation.Max(MaxListLimit)),
+		validation.Field(&r.Wait, validation.Min(0), validation.Max(MaxWait)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "event list validation error")
+}
+
+// StreamEventsRequest streams the events after a cursor as Server-Sent Events. The
+// Last-Event-ID header sent by reconnecting clients takes precedence over the after parameter.
+type StreamEventsRequest struct {
+	After       string `json:"after" query:"after"`
+	LastEventID string `json:"-"`
+}
+
+// Cursor returns the cursor to stream from.
+func (r StreamEventsRequest) Cursor() string {
+	if r.LastEventID != "" {
+		return r.LastEventID
+	}
+
+	return r.After
+}
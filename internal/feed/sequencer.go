@@ -0,0 +1,67 @@
+package feed
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"github.com/safayildirim/wallet-management-service/pkg/worker"
+	"time"
+)
+
+// Sequencer periodically hands out feed positions to the events recorded in the outbox, making
+// them visible in the feed.
+type Sequencer struct {
+	feedRepository Repository
+	interval       time.Duration
+	batchSize      int
+}
+
+func NewSequencer(feedRepository Repository, conf config.EventFeedConfig) (*Sequencer, error) {
+	if err := worker.Validate(conf.SequenceInterval, conf.SequenceBatchSize); err != nil {
+		return nil, errors.Wrap(err, "invalid event sequencer config")
+	}
+
+	return &Sequencer{
+		feedRepository: feedRepository,
+		interval:       conf.SequenceInterval,
+		batchSize:      conf.SequenceBatchSize,
+	}, nil
+}
+
+// Run sequences new events on every tick until the context is cancelled.
+func (s *Sequencer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		sequenced, err := s.sequence(ctx)
+		if err != nil {
+			logger.Zap.Sugar().Errorf("event feed sequencing failed: %v", err)
+		} else if sequenced > 0 {
+			logger.Zap.Sugar().Debugf("sequenced %d events into the feed", sequenced)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// sequence sequences new events in batches, each in its own transaction.
+func (s *Sequencer) sequence(ctx context.Context) (int64, error) {
+	var total int64
+	for {
+		sequenced, err := s.feedRepository.SequenceEvents(ctx, s.batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		total += sequenced
+		if sequenced < int64(s.batchSize) || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package feed
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	feedmock "github.com/safayildirim/wallet-management-service/internal/feed/mock"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSequencer_Sequence(t *testing.T) {
+	tests := []struct {
+		name          string
+		batches       []int64
+		mockError     error
+		expectedTotal int64
+		expectErr     bool
+	}{
+		{
+			name:          "when backlog spans several batches then should keep sequencing until a partial batch",
+			batches:       []int64{2, 2, 1},
+			expectedTotal: 5,
+		},
+		{
+			name:          "when nothing is new then should sequence nothing",
+			batches:       []int64{0},
+			expectedTotal: 0,
+		},
+		{
+			name:          "when repository returns an error then should stop and return error",
+			batches:       []int64{2},
+			mockError:     errors.New("repository error"),
+			expectedTotal: 2,
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := feedmock.NewMockFeedRepository(t)
+			s, err := NewSequencer(mockRepository, config.EventFeedConfig{SequenceInterval: time.Second, SequenceBatchSize: 2})
+			assert.NoError(t, err)
+
+			for _, sequenced := range tt.batches {
+				mockRepository.EXPECT().SequenceEvents(context.Background(), 2).Return(sequenced, nil).Once()
+			}
+			if tt.mockError != nil {
+				mockRepository.EXPECT().SequenceEvents(context.Background(), 2).Return(0, tt.mockError).Once()
+			}
+
+			total, err := s.sequence(context.Background())
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedTotal, total)
+		})
+	}
+}
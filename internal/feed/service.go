@@ -0,0 +1,106 @@
+package feed
+
+import (
+	"context"
+	"github.com/safayildirim/wallet-management-service/internal/auth"
+	"github.com/safayildirim/wallet-management-service/internal/common"
+	"github.com/safayildirim/wallet-management-service/internal/feed/entity"
+	"github.com/safayildirim/wallet-management-service/internal/feed/request"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"time"
+)
+
+type Service interface {
+	ListEvents(ctx context.Context, request *request.ListEventsRequest) ([]*entity.Event, string, error)
+}
+
+const defaultListLimit = request.DefaultListLimit
+
+type service struct {
+	feedRepository Repository
+	pollInterval   time.Duration
+}
+
+func NewService(feedRepository Repository, conf config.EventFeedConfig) Service {
+	return &service{
+		feedRepository: feedRepository,
+		pollInterval:   conf.PollInterval,
+	}
+}
+
+// ListEvents retrieves the events after a cursor, oldest first. If there are none and the
+// request asks to wait, the feed is polled until events arrive, the wait is over or the context
+// is done, whichever comes first.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - request: Request object containing the cursor, the page size and the wait.
+//
+// Returns:
+//   - The events of the page, which may be empty.
+//   - The cursor to continue from: that of the last event, or the given one if there are none.
+//   - An error if the cursor is invalid or retrieval fails.
+func (s *service) ListEvents(ctx context.Context, request *request.ListEventsRequest) ([]*entity.Event, string, error) {
+	filter := entity.EventFilter{Limit: request.Limit}
+	if filter.Limit == 0 {
+		filter.Limit = defaultListLimit
+	}
+
+	if request.After != "" {
+		var cursor eventCursor
+		if err := common.DecodeCursor(request.After, &cursor); err != nil {
+			return nil, "", ErrInvalidCursor
+		}
+		filter.AfterPosition = cursor.Position
+	}
+
+	// Scoped callers only ever see the events of their own wallets.
+	if scope, ok := auth.ScopeFrom(ctx); ok {
+		filter.OwnerID = &scope.OwnerID
+	}
+
+	deadline := time.Now().Add(time.Duration(request.Wait) * time.Second)
+	for {
+		items, err := s.feedRepository.ListEvents(ctx, filter)
+		if err != nil {
+			return nil, "", err
+		}
+
+		remaining := time.Until(deadline)
+		if len(items) > 0 || remaining <= 0 {
+			return s.page(items, filter.AfterPosition)
+		}
+
+		timer := time.NewTimer(min(s.pollInterval, remaining))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return s.page(items, filter.AfterPosition)
+		case <-timer.C:
+		}
+	}
+}
+
+// page returns the events together with the cursor to continue from.
+func (s *service) page(items []*entity.Event, after uint64) ([]*entity.Event, string, error) {
+	if len(items) > 0 {
+		after = items[len(items)-1].Position
+	}
+
+	next, err := encodeCursor(after)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return items, next, nil
+}
+
+// eventCursor is the decoded form of the opaque cursor returned by ListEvents.
+type eventCursor struct {
+	Position uint64 `json:"p"`
+}
+
+// encodeCursor returns the cursor to continue from after the event at the given position.
+func encodeCursor(position uint64) (string, error) {
+	return common.EncodeCursor(eventCursor{Position: position})
+}
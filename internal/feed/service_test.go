@@ -0,0 +1,150 @@
+package feed
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/auth"
+	"github.com/safayildirim/wallet-management-service/internal/feed/entity"
+	feedmock "github.com/safayildirim/wallet-management-service/internal/feed/mock"
+	"github.com/safayildirim/wallet-management-service/internal/feed/request"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestService_ListEvents(t *testing.T) {
+	cursor, _ := encodeCursor(5)
+	owner := "owner1"
+
+	tests := []struct {
+		name               string
+		ctx                context.Context
+		request            *request.ListEventsRequest
+		mockReturns        [][]*entity.Event
+		mockReturnErr      error
+		mockKeepsPolling   bool
+		expectedFilter     entity.EventFilter
+		expectedPositions  []uint64
+		expectedNextCursor uint64
+		expectedErr        error
+		expectErr          bool
+	}{
+		{
+			name:               "when there are events after the cursor then should return them right away",
+			ctx:                context.Background(),
+			request:            &request.ListEventsRequest{After: cursor, Limit: 2, Wait: 30},
+			mockReturns:        [][]*entity.Event{{{Position: 6}, {Position: 7}}},
+			expectedFilter:     entity.EventFilter{AfterPosition: 5, Limit: 2},
+			expectedPositions:  []uint64{6, 7},
+			expectedNextCursor: 7,
+		},
+		{
+			name:               "when no cursor is given then should read from the start",
+			ctx:                context.Background(),
+			request:            &request.ListEventsRequest{},
+			mockReturns:        [][]*entity.Event{{{Position: 1}}},
+			expectedFilter:     entity.EventFilter{Limit: request.DefaultListLimit},
+			expectedPositions:  []uint64{1},
+			expectedNextCursor: 1,
+		},
+		{
+			name:               "when events arrive while waiting then should return them",
+			ctx:                context.Background(),
+			request:            &request.ListEventsRequest{After: cursor, Wait: 30},
+			mockReturns:        [][]*entity.Event{{}, {}, {{Position: 6}}},
+			expectedFilter:     entity.EventFilter{AfterPosition: 5, Limit: request.DefaultListLimit},
+			expectedPositions:  []uint64{6},
+			expectedNextCursor: 6,
+		},
+		{
+			name:               "when no events arrive while waiting then should return the given cursor",
+			ctx:                context.Background(),
+			request:            &request.ListEventsRequest{After: cursor, Wait: 1},
+			mockKeepsPolling:   true,
+			expectedFilter:     entity.EventFilter{AfterPosition: 5, Limit: request.DefaultListLimit},
+			expectedPositions:  []uint64{},
+			expectedNextCursor: 5,
+		},
+		{
+			name:               "when caller is scoped then should only list the events of the caller's wallets",
+			ctx:                auth.WithScope(context.Background(), auth.Scope{OwnerID: owner}),
+			request:            &request.ListEventsRequest{},
+			mockReturns:        [][]*entity.Event{{{Position: 3}}},
+			expectedFilter:     entity.EventFilter{OwnerID: &owner, Limit: request.DefaultListLimit},
+			expectedPositions:  []uint64{3},
+			expectedNextCursor: 3,
+		},
+		{
+			name:        "when cursor is invalid then should return invalid cursor",
+			ctx:         context.Background(),
+			request:     &request.ListEventsRequest{After: "not-a-cursor"},
+			expectedErr: ErrInvalidCursor,
+			expectErr:   true,
+		},
+		{
+			name:           "when repository returns an error then should return error",
+			ctx:            context.Background(),
+			request:        &request.ListEventsRequest{},
+			mockReturnErr:  errors.New("repository error"),
+			expectedFilter: entity.EventFilter{Limit: request.DefaultListLimit},
+			expectErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := feedmock.NewMockFeedRepository(t)
+			s := NewService(mockRepository, config.EventFeedConfig{PollInterval: time.Millisecond})
+
+			for _, events := range tt.mockReturns {
+				mockRepository.EXPECT().ListEvents(mock.Anything, tt.expectedFilter).Return(events, nil).Once()
+			}
+			if tt.mockReturnErr != nil {
+				mockRepository.EXPECT().ListEvents(mock.Anything, tt.expectedFilter).Return(nil, tt.mockReturnErr).Once()
+			}
+			if tt.mockKeepsPolling {
+				mockRepository.EXPECT().ListEvents(mock.Anything, tt.expectedFilter).Return([]*entity.Event{}, nil)
+			}
+
+			events, next, err := s.ListEvents(tt.ctx, tt.request)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			positions := make([]uint64, 0, len(events))
+			for _, event := range events {
+				positions = append(positions, event.Position)
+			}
+			assert.Equal(t, tt.expectedPositions, positions)
+			expectedNext, _ := encodeCursor(tt.expectedNextCursor)
+			assert.Equal(t, expectedNext, next)
+		})
+	}
+}
+
+func TestService_ListEvents_ContextDone(t *testing.T) {
+	mockRepository := feedmock.NewMockFeedRepository(t)
+	s := NewService(mockRepository, config.EventFeedConfig{PollInterval: time.Hour})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mockRepository.EXPECT().ListEvents(mock.Anything, mock.Anything).
+		RunAndReturn(func(context.Context, entity.EventFilter) ([]*entity.Event, error) {
+			cancel()
+			return []*entity.Event{}, nil
+		}).Once()
+
+	events, next, err := s.ListEvents(ctx, &request.ListEventsRequest{Wait: request.MaxWait})
+
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+	expectedNext, _ := encodeCursor(0)
+	assert.Equal(t, expectedNext, next)
+}
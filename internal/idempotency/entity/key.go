@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"net/http"
+	"time"
+)
+
+// Key records the outcome of a request carrying an Idempotency-Key header, so that retries
+// of the request are answered with the original response instead of being executed again.
+// Keys are scoped to the owner of the caller; callers without a scope share the empty owner.
+type Key struct {
+	OwnerID     string `gorm:"primaryKey"`
+	Key         string `gorm:"primaryKey"`
+	RequestHash string
+	StatusCode  int         // Zero while the original request is in progress.
+	Header      http.Header `gorm:"serializer:json"`
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	// LockedUntil is when an incomplete key is considered abandoned, e.g. by a crashed
+	// instance, and may be reserved again.
+	LockedUntil time.Time
+	// ReservationID identifies the request that reserved the key, so that a request whose lock
+	// has run out cannot complete or release the key once a retry has reserved it again.
+	ReservationID string
+}
+
+func (Key) TableName() string {
+	return "idempotency_keys"
+}
+
+// Completed reports whether the response of the original request has been recorded.
+func (k Key) Completed() bool {
+	return k.StatusCode != 0
+}
@@ -0,0 +1,9 @@
+package idempotency
+
+import "github.com/safayildirim/wallet-management-service/internal/apperror"
+
+var (
+	ErrInvalidKey        = apperror.Validation("invalid_idempotency_key", "Idempotency-Key header is too long")
+	ErrKeyReused         = apperror.Unprocessable("idempotency_key_reused", "Idempotency-Key has already been used for a different request")
+	ErrRequestInProgress = apperror.Conflict("idempotency_request_in_progress", "a request with the same Idempotency-Key is still in progress")
+)
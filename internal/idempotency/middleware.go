@@ -0,0 +1,231 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/auth"
+	"github.com/safayildirim/wallet-management-service/internal/idempotency/entity"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	// HeaderIdempotencyKey carries a client-generated key that identifies a request across retries.
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// HeaderIdempotentReplayed is set on responses replayed from a previous request.
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	MaxKeyLength = 255
+
+	// Recording a response is retried after completeRetryBackoff, doubled on every attempt up to
+	// maxCompleteRetryBackoff, for as long as the key is locked.
+	completeRetryBackoff    = 50 * time.Millisecond
+	maxCompleteRetryBackoff = 2 * time.Second
+)
+
+// replayedHeaders are the response headers stored with a key and replayed together with its body.
+var replayedHeaders = []string{echo.HeaderContentType, echo.HeaderLocation, "ETag"}
+
+// Middleware makes mutating requests carrying the Idempotency-Key header safe to retry. The
+// first request with a key is executed and its response recorded; later requests with the
+// same key and caller get the recorded response replayed, as long as the key has not expired.
+// Reusing a key for a different request is rejected, and so is a retry that arrives while the
+// original request is still in progress. Server errors are not recorded, so such requests may
+// be retried with the same key. The body of a request with a key is read up front to fingerprint
+// the request, and is rejected if larger than the configured maximum.
+//
+// The middleware has to run after auth.Middleware, as keys are scoped to the caller's owner.
+func Middleware(repository Repository, conf config.IdempotencyConfig) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			keyValue := req.Header.Get(HeaderIdempotencyKey)
+			if keyValue == "" || !mutating(req.Method) {
+				return next(c)
+			}
+
+			if len(keyValue) > MaxKeyLength {
+				return ErrInvalidKey
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(c.Response(), req.Body, int64(conf.MaxBodySize)))
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					return echo.ErrStatusRequestEntityTooLarge
+				}
+
+				return apperror.InvalidRequest(err)
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			var ownerID string
+			if scope, ok := auth.ScopeFrom(req.Context()); ok {
+				ownerID = scope.OwnerID
+			}
+
+			now := time.Now()
+			key := &entity.Key{
+				OwnerID:       ownerID,
+				Key:           keyValue,
+				RequestHash:   hashRequest(req, body),
+				CreatedAt:     now,
+				ExpiresAt:     now.Add(conf.KeyTTL),
+				LockedUntil:   now.Add(conf.LockTimeout),
+				ReservationID: newReservationID(),
+			}
+
+			existing, err := repository.Reserve(req.Context(), key)
+			if err != nil {
+				return err
+			}
+
+			if existing != nil {
+				return replay(c, existing, key.RequestHash)
+			}
+
+			return execute(c, next, repository, key)
+		}
+	}
+}
+
+// execute runs the request a key has been reserved for and records its response. The
+// reservation is released if the request fails with a server error or panics. Once the
+// request has taken effect, the key is never released, as that would let a retry execute the
+// request again.
+func execute(c echo.Context, next echo.HandlerFunc, repository Repository, key *entity.Key) error {
+	// The outcome has to be recorded even if the client has gone away in the meantime.
+	ctx := context.WithoutCancel(c.Request().Context())
+
+	settled := false
+	defer func() {
+		if settled {
+			return
+		}
+
+		if err := repository.Release(ctx, key); err != nil {
+			logger.Zap.Error("idempotency key release failed", zap.Error(err), zap.String("key", key.Key))
+		}
+	}()
+
+	recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+	c.Response().Writer = recorder
+
+	// Errors are rendered here, as the rendered response is what gets replayed.
+	if err := next(c); err != nil {
+		c.Error(err)
+	}
+
+	res := c.Response()
+	if res.Status >= http.StatusInternalServerError {
+		return nil
+	}
+	settled = true
+
+	key.StatusCode = res.Status
+	key.Header = make(http.Header)
+	for _, name := range replayedHeaders {
+		if values := res.Header().Values(name); len(values) > 0 {
+			key.Header[name] = values
+		}
+	}
+	key.Body = recorder.body.Bytes()
+
+	err := complete(ctx, repository, key)
+	switch {
+	case errors.Is(err, errReservationLost):
+		logger.Zap.Error("idempotency key was reserved again before its request completed, "+
+			"IDEMPOTENCY_LOCK_TIMEOUT is shorter than the request", zap.String("key", key.Key))
+	case err != nil:
+		logger.Zap.Error("idempotency key completion failed, key stays locked",
+			zap.Error(err), zap.String("key", key.Key))
+	}
+
+	return nil
+}
+
+// complete records the response of a key. As the request cannot be undone, it is retried until
+// the lock of the key runs out; until then retries of the request are told that it is in progress.
+func complete(ctx context.Context, repository Repository, key *entity.Key) error {
+	backoff := completeRetryBackoff
+	for {
+		err := repository.Complete(ctx, key)
+		if err == nil || errors.Is(err, errReservationLost) || time.Now().Add(backoff).After(key.LockedUntil) {
+			return err
+		}
+
+		time.Sleep(backoff)
+		backoff = min(2*backoff, maxCompleteRetryBackoff)
+	}
+}
+
+// replay answers a request with the recorded response of the key it reuses.
+func replay(c echo.Context, key *entity.Key, requestHash string) error {
+	if key.RequestHash != requestHash {
+		return ErrKeyReused
+	}
+
+	if !key.Completed() {
+		return ErrRequestInProgress
+	}
+
+	res := c.Response()
+	for name, values := range key.Header {
+		for _, value := range values {
+			res.Header().Add(name, value)
+		}
+	}
+	res.Header().Set(HeaderIdempotentReplayed, "true")
+	res.WriteHeader(key.StatusCode)
+	_, err := res.Write(key.Body)
+
+	return err
+}
+
+// newReservationID returns a random identifier of a reservation of a key.
+func newReservationID() string {
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+
+	return hex.EncodeToString(id)
+}
+
+// hashRequest fingerprints the method, target and body of a request.
+func hashRequest(req *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(req.Method + " " + req.URL.RequestURI() + "\n"))
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func mutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// responseRecorder captures the body written to the response.
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
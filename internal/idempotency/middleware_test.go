@@ -0,0 +1,277 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/auth"
+	"github.com/safayildirim/wallet-management-service/internal/idempotency/entity"
+	idempotencymock "github.com/safayildirim/wallet-management-service/internal/idempotency/mock"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMiddleware(t *testing.T) {
+	const body = `{"owner_id":"customer-1"}`
+	requestHash := hashFor(http.MethodPost, "/wallets", body)
+
+	tests := []struct {
+		name            string
+		method          string
+		key             string
+		ownerID         string
+		handlerStatus   int
+		handlerErr      error
+		existing        *entity.Key
+		reserveErr      error
+		expectReserve   bool
+		expectComplete  bool
+		completeErr     error
+		expectRelease   bool
+		expectHandler   bool
+		expectedStatus  int
+		expectedBody    string
+		expectReplayed  bool
+		expectedErr     error
+		expectedOwnerID string
+		maxBodySize     int
+	}{
+		{
+			name:           "when key is missing then should pass request through",
+			method:         http.MethodPost,
+			handlerStatus:  http.StatusCreated,
+			expectHandler:  true,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":1}`,
+		},
+		{
+			name:           "when request is not mutating then should pass request through",
+			method:         http.MethodGet,
+			key:            "key-1",
+			handlerStatus:  http.StatusOK,
+			expectHandler:  true,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":1}`,
+		},
+		{
+			name:            "when key is new then should execute request and record response",
+			method:          http.MethodPost,
+			key:             "key-1",
+			ownerID:         "customer-1",
+			handlerStatus:   http.StatusCreated,
+			expectReserve:   true,
+			expectHandler:   true,
+			expectComplete:  true,
+			expectedStatus:  http.StatusCreated,
+			expectedBody:    `{"id":1}`,
+			expectedOwnerID: "customer-1",
+		},
+		{
+			name:           "when response cannot be recorded then should keep key reserved",
+			method:         http.MethodPost,
+			key:            "key-1",
+			handlerStatus:  http.StatusCreated,
+			expectReserve:  true,
+			expectHandler:  true,
+			expectComplete: true,
+			completeErr:    errors.New("database is down"),
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":1}`,
+		},
+		{
+			name:           "when key has been reserved again meanwhile then should stop recording response",
+			method:         http.MethodPost,
+			key:            "key-1",
+			handlerStatus:  http.StatusCreated,
+			expectReserve:  true,
+			expectHandler:  true,
+			expectComplete: true,
+			completeErr:    errReservationLost,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":1}`,
+		},
+		{
+			name:           "when request fails with a client error then should record error response",
+			method:         http.MethodPost,
+			key:            "key-1",
+			handlerErr:     apperror.Conflict("wallet_address_already_exists", "address is already registered to a wallet"),
+			expectReserve:  true,
+			expectHandler:  true,
+			expectComplete: true,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "when request fails with a server error then should release key",
+			method:         http.MethodPost,
+			key:            "key-1",
+			handlerErr:     errors.New("database is down"),
+			expectReserve:  true,
+			expectHandler:  true,
+			expectRelease:  true,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:   "when key has been completed then should replay response",
+			method: http.MethodPost,
+			key:    "key-1",
+			existing: &entity.Key{
+				Key: "key-1", RequestHash: requestHash, StatusCode: http.StatusCreated,
+				Header: http.Header{echo.HeaderContentType: {echo.MIMEApplicationJSON}}, Body: []byte(`{"id":1}`),
+			},
+			expectReserve:  true,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":1}`,
+			expectReplayed: true,
+		},
+		{
+			name:          "when key has been used for another request then should return error",
+			method:        http.MethodPost,
+			key:           "key-1",
+			existing:      &entity.Key{Key: "key-1", RequestHash: "other", StatusCode: http.StatusCreated},
+			expectReserve: true,
+			expectedErr:   ErrKeyReused,
+		},
+		{
+			name:          "when original request is in progress then should return error",
+			method:        http.MethodPost,
+			key:           "key-1",
+			existing:      &entity.Key{Key: "key-1", RequestHash: requestHash},
+			expectReserve: true,
+			expectedErr:   ErrRequestInProgress,
+		},
+		{
+			name:          "when reservation fails then should return error",
+			method:        http.MethodPost,
+			key:           "key-1",
+			reserveErr:    errors.New("repository error"),
+			expectReserve: true,
+			expectedErr:   errors.New("repository error"),
+		},
+		{
+			name:        "when body is larger than the maximum then should return error",
+			method:      http.MethodPost,
+			key:         "key-1",
+			maxBodySize: len(body) - 1,
+			expectedErr: echo.ErrStatusRequestEntityTooLarge,
+		},
+		{
+			name:        "when key is too long then should return error",
+			method:      http.MethodPost,
+			key:         strings.Repeat("k", MaxKeyLength+1),
+			expectedErr: ErrInvalidKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.HTTPErrorHandler = apperror.NewHTTPErrorHandler()
+			mockRepository := idempotencymock.NewMockIdempotencyRepository(t)
+			maxBodySize := tt.maxBodySize
+			if maxBodySize == 0 {
+				maxBodySize = 1 << 10
+			}
+			m := Middleware(mockRepository, config.IdempotencyConfig{
+				KeyTTL: time.Hour, LockTimeout: 200 * time.Millisecond, MaxBodySize: maxBodySize,
+			})
+
+			if tt.expectReserve {
+				mockRepository.EXPECT().Reserve(mock.Anything, mock.MatchedBy(func(k *entity.Key) bool {
+					return k.OwnerID == tt.expectedOwnerID && k.Key == tt.key && k.RequestHash == requestHash &&
+						k.ExpiresAt.Sub(k.CreatedAt) == time.Hour && k.LockedUntil.Sub(k.CreatedAt) == 200*time.Millisecond &&
+						len(k.ReservationID) == 32
+				})).Return(tt.existing, tt.reserveErr).Once()
+			}
+			if tt.expectComplete {
+				// A failing completion is retried while the key is locked, unless the key has been
+				// reserved again, and the key is never released
+				call := mockRepository.EXPECT().Complete(mock.Anything, mock.MatchedBy(func(k *entity.Key) bool {
+					return k.StatusCode == tt.expectedStatus && k.Header.Get(echo.HeaderContentType) != ""
+				})).Return(tt.completeErr)
+				if tt.completeErr == nil || errors.Is(tt.completeErr, errReservationLost) {
+					call.Once()
+				}
+			}
+			if tt.expectRelease {
+				mockRepository.EXPECT().Release(mock.Anything, mock.MatchedBy(func(k *entity.Key) bool {
+					return len(k.ReservationID) == 32
+				})).Return(nil).Once()
+			}
+
+			handlerCalled := false
+			handler := func(c echo.Context) error {
+				handlerCalled = true
+				received, err := io.ReadAll(c.Request().Body)
+				assert.NoError(t, err)
+				assert.Equal(t, body, string(received))
+				if tt.handlerErr != nil {
+					return tt.handlerErr
+				}
+
+				return c.JSONBlob(tt.handlerStatus, []byte(`{"id":1}`))
+			}
+
+			req := httptest.NewRequest(tt.method, "/wallets", bytes.NewBufferString(body))
+			if tt.key != "" {
+				req.Header.Set(HeaderIdempotencyKey, tt.key)
+			}
+			if tt.ownerID != "" {
+				req = req.WithContext(auth.WithScope(req.Context(), auth.Scope{OwnerID: tt.ownerID}))
+			}
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := m(handler)(ctx)
+
+			if tt.expectedErr != nil {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+				assert.False(t, handlerCalled)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectHandler, handlerCalled)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+			if tt.expectReplayed {
+				assert.Equal(t, "true", rec.Header().Get(HeaderIdempotentReplayed))
+				assert.Equal(t, echo.MIMEApplicationJSON, rec.Header().Get(echo.HeaderContentType))
+			}
+		})
+	}
+}
+
+func TestSweeper_Sweep(t *testing.T) {
+	cutoff := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockRepository := idempotencymock.NewMockIdempotencyRepository(t)
+	s, err := NewSweeper(mockRepository, config.IdempotencyConfig{SweepInterval: time.Minute, SweepBatchSize: 2})
+	assert.NoError(t, err)
+
+	mockRepository.EXPECT().DeleteExpiredKeys(context.Background(), cutoff, 2).Return(2, nil).Once()
+	mockRepository.EXPECT().DeleteExpiredKeys(context.Background(), cutoff, 2).Return(1, nil).Once()
+
+	total, err := s.sweep(context.Background(), cutoff)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+}
+
+func hashFor(method, target, body string) string {
+	hash := sha256.Sum256([]byte(method + " " + target + "\n" + body))
+	return hex.EncodeToString(hash[:])
+}
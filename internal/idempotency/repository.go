@@ -0,0 +1,103 @@
+package idempotency
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/idempotency/entity"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// errReservationLost is returned when a key is completed or released by a request whose
+// reservation has run out and been taken over by a retry.
+var errReservationLost = errors.New("idempotency key has been reserved by another request")
+
+type Repository interface {
+	Reserve(ctx context.Context, key *entity.Key) (*entity.Key, error)
+	Complete(ctx context.Context, key *entity.Key) error
+	Release(ctx context.Context, key *entity.Key) error
+	DeleteExpiredKeys(ctx context.Context, expiredBefore time.Time, limit int) (int64, error)
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+// Reserve records a key for a request that is about to be executed. If the caller has
+// already used the key, nothing is recorded and the existing key is returned instead; an
+// expired key, or an incomplete one whose lock has run out, is replaced as if it had never
+// been used.
+func (r *repository) Reserve(ctx context.Context, key *entity.Key) (*entity.Key, error) {
+	var existing *entity.Key
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where(
+			"owner_id = ? AND key = ? AND (expires_at <= ? OR (status_code = 0 AND locked_until <= ?))",
+			key.OwnerID, key.Key, key.CreatedAt, key.CreatedAt,
+		).Delete(&entity.Key{}).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(key)
+		if result.Error != nil || result.RowsAffected == 1 {
+			return result.Error
+		}
+
+		existing = &entity.Key{}
+		err = tx.Where("owner_id = ? AND key = ?", key.OwnerID, key.Key).Take(existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// The key was released by the original request in the meantime.
+			return ErrRequestInProgress
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+// Complete stores the response of the request the key was reserved for. If the reservation has
+// been taken over by a retry in the meantime, nothing is stored and errReservationLost is returned.
+func (r *repository) Complete(ctx context.Context, key *entity.Key) error {
+	result := r.db.WithContext(ctx).Model(key).
+		Where("reservation_id = ? AND status_code = 0", key.ReservationID).
+		Select("status_code", "header", "body").Updates(key)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errReservationLost
+	}
+
+	return nil
+}
+
+// Release removes a key whose request did not complete, so that it can be retried. A key whose
+// reservation has been taken over by a retry in the meantime is left to that retry.
+func (r *repository) Release(ctx context.Context, key *entity.Key) error {
+	return r.db.WithContext(ctx).
+		Where("owner_id = ? AND key = ? AND reservation_id = ? AND status_code = 0", key.OwnerID, key.Key, key.ReservationID).
+		Delete(&entity.Key{}).Error
+}
+
+// DeleteExpiredKeys permanently removes up to limit keys that expired before the given time.
+func (r *repository) DeleteExpiredKeys(ctx context.Context, expiredBefore time.Time, limit int) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(
+		"DELETE FROM idempotency_keys WHERE ctid IN (SELECT ctid FROM idempotency_keys WHERE expires_at < ? LIMIT ?)",
+		expiredBefore, limit,
+	)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
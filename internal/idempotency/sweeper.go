@@ -0,0 +1,67 @@
+package idempotency
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"github.com/safayildirim/wallet-management-service/pkg/worker"
+	"time"
+)
+
+// Sweeper periodically deletes expired idempotency keys. Expired keys are ignored by the
+// middleware already; sweeping merely keeps the table from growing without bound.
+type Sweeper struct {
+	repository Repository
+	interval   time.Duration
+	batchSize  int
+}
+
+func NewSweeper(repository Repository, conf config.IdempotencyConfig) (*Sweeper, error) {
+	if err := worker.Validate(conf.SweepInterval, conf.SweepBatchSize); err != nil {
+		return nil, errors.Wrap(err, "invalid idempotency sweeper config")
+	}
+
+	return &Sweeper{
+		repository: repository,
+		interval:   conf.SweepInterval,
+		batchSize:  conf.SweepBatchSize,
+	}, nil
+}
+
+// Run deletes expired keys on every tick until the context is cancelled.
+func (s *Sweeper) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := s.sweep(ctx, time.Now())
+		if err != nil {
+			logger.Zap.Sugar().Errorf("idempotency key sweep failed: %v", err)
+		} else if deleted > 0 {
+			logger.Zap.Sugar().Infof("deleted %d expired idempotency keys", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweep deletes keys expired before the cutoff in batches.
+func (s *Sweeper) sweep(ctx context.Context, cutoff time.Time) (int64, error) {
+	var total int64
+	for {
+		deleted, err := s.repository.DeleteExpiredKeys(ctx, cutoff, s.batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		total += deleted
+		if deleted < int64(s.batchSize) || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package ledger
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/asset"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/entity"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/request"
+	"github.com/safayildirim/wallet-management-service/internal/wallet"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"github.com/segmentio/kafka-go"
+	"strconv"
+	"time"
+)
+
+const (
+	HeaderDeadLetterError     = "dead_letter_error"
+	HeaderDeadLetterTopic     = "dead_letter_topic"
+	HeaderDeadLetterPartition = "dead_letter_partition"
+	HeaderDeadLetterOffset    = "dead_letter_offset"
+
+	maxDepositRetryBackoff = time.Minute
+)
+
+// pendingDepositErrors are the states of a wallet, address or asset that keep a deposit from
+// being credited for now. The funds have arrived on chain all the same, so deposits running into
+// them are parked and retried rather than dead-lettered.
+var pendingDepositErrors = []error{
+	wallet.ErrWalletNotFound,
+	wallet.ErrWalletFrozen,
+	wallet.ErrWalletNotActive,
+	wallet.ErrUnknownNetwork,
+	wallet.ErrNetworkDisabled,
+	asset.ErrUnknownAsset,
+	asset.ErrAssetDisabled,
+}
+
+// DepositReader reads the deposit notifications of a consumer group and commits their offsets.
+type DepositReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// DeadLetterWriter sets aside the deposit notifications that are malformed and can never be
+// credited.
+type DeadLetterWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// NewDepositReader returns a reader of the deposits topic joining the configured consumer group.
+// Offsets are only committed explicitly.
+func NewDepositReader(conf config.KafkaConfig) DepositReader {
+	return kafka.NewReader(kafka.ReaderConfig{
+		Brokers: conf.Brokers,
+		GroupID: conf.DepositsGroupID,
+		Topic:   conf.DepositsTopic,
+	})
+}
+
+// NewDeadLetterWriter returns a writer to the dead-letter topic of the deposits.
+func NewDeadLetterWriter(conf config.KafkaConfig) DeadLetterWriter {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(conf.Brokers...),
+		Topic:        conf.DepositsDeadLetterTopic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}
+}
+
+// DepositConsumer credits the deposits detected on chain, as published by the blockchain
+// indexer, to the wallets holding the receiving addresses.
+//
+// The offset of a notification is committed only once its deposit has been committed to the
+// database, or the notification has been parked or dead-lettered, so every notification is handled
+// at least once; crediting is idempotent on the chain output, so it is credited at most once.
+// Malformed notifications go to the dead-letter topic. Notifications that cannot be credited yet,
+// such as ones for a frozen wallet or an address that is not registered yet, are parked for the
+// DepositRetrier. Any other failure is retried with backoff, holding up the partition.
+type DepositConsumer struct {
+	reader             DepositReader
+	deadLetters        DeadLetterWriter
+	ledgerService      Service
+	ledgerRepository   Repository
+	retryBackoff       time.Duration
+	parkedRetryBackoff time.Duration
+}
+
+func NewDepositConsumer(reader DepositReader, deadLetters DeadLetterWriter, ledgerService Service, ledgerRepository Repository, conf config.LedgerConfig) *DepositConsumer {
+	return &DepositConsumer{
+		reader:             reader,
+		deadLetters:        deadLetters,
+		ledgerService:      ledgerService,
+		ledgerRepository:   ledgerRepository,
+		retryBackoff:       conf.DepositRetryBackoff,
+		parkedRetryBackoff: conf.ParkedDepositInterval,
+	}
+}
+
+// Run handles deposit notifications one at a time until the context is cancelled, then closes
+// the reader and the dead-letter writer.
+func (c *DepositConsumer) Run(ctx context.Context) error {
+	defer c.deadLetters.Close()
+	defer c.reader.Close()
+
+	for {
+		message, err := c.reader.FetchMessage(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := c.process(ctx, message); err != nil {
+			return err
+		}
+	}
+}
+
+// process handles the message, retrying until it succeeds or the context is cancelled, and
+// commits its offset.
+func (c *DepositConsumer) process(ctx context.Context, message kafka.Message) error {
+	backoff := c.retryBackoff
+	for {
+		err := c.handle(ctx, message)
+		if err == nil {
+			break
+		}
+
+		logger.Zap.Sugar().Errorf("deposit at offset %d of partition %d failed, retrying in %s: %v",
+			message.Offset, message.Partition, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff = min(2*backoff, maxDepositRetryBackoff)
+	}
+
+	// A failed commit is not fatal: the message is redelivered and found credited or parked already.
+	if err := c.reader.CommitMessages(ctx, message); err != nil {
+		logger.Zap.Sugar().Errorf("committing deposit at offset %d of partition %d failed: %v",
+			message.Offset, message.Partition, err)
+	}
+
+	return nil
+}
+
+// handle credits the deposit of the message, parks the message if it cannot be credited yet or
+// dead-letters it if it is malformed. Only failures worth retrying are returned.
+func (c *DepositConsumer) handle(ctx context.Context, message kafka.Message) error {
+	deposit, err := creditDeposit(ctx, c.ledgerService, message)
+	switch {
+	case err == nil:
+		logger.Zap.Sugar().Infof("credited deposit %s of %s %s to wallet %d",
+			deposit.Reference(), deposit.Amount, deposit.Asset, deposit.WalletID)
+		return nil
+	case errors.Is(err, ErrDepositAlreadyCredited):
+		return nil
+	case isPendingDeposit(err):
+		return c.park(ctx, message, err)
+	case apperror.KindOf(err) != apperror.KindInternal:
+		return deadLetter(ctx, c.deadLetters, message, err)
+	default:
+		return err
+	}
+}
+
+// park sets the message aside to be retried by the DepositRetrier.
+func (c *DepositConsumer) park(ctx context.Context, message kafka.Message, reason error) error {
+	logger.Zap.Sugar().Warnf("parking deposit at offset %d of partition %d: %v",
+		message.Offset, message.Partition, reason)
+
+	return c.ledgerRepository.ParkDeposit(ctx, &entity.ParkedDeposit{
+		SourceTopic:     message.Topic,
+		SourcePartition: message.Partition,
+		SourceOffset:    message.Offset,
+		Key:             message.Key,
+		Value:           message.Value,
+		Reason:          deadLetterReason(reason),
+		Attempts:        1,
+		NextAttemptAt:   time.Now().Add(c.parkedRetryBackoff),
+	})
+}
+
+// isPendingDeposit reports whether a deposit failed on a state that may still change, so that it
+// can be credited later.
+func isPendingDeposit(err error) bool {
+	for _, pending := range pendingDepositErrors {
+		if errors.Is(err, pending) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// creditDeposit decodes the deposit notification of the message and credits it.
+func creditDeposit(ctx context.Context, ledgerService Service, message kafka.Message) (*entity.ChainDeposit, error) {
+	var deposit request.ChainDepositRequest
+	if err := json.Unmarshal(message.Value, &deposit); err != nil {
+		return nil, apperror.InvalidRequest(err)
+	}
+
+	if err := deposit.Validate(); err != nil {
+		return nil, apperror.InvalidRequest(err)
+	}
+
+	return ledgerService.CreditChainDeposit(ctx, &deposit)
+}
+
+// deadLetter writes the message to the dead-letter topic along with the reason and its origin.
+func deadLetter(ctx context.Context, deadLetters DeadLetterWriter, message kafka.Message, reason error) error {
+	logger.Zap.Sugar().Warnf("dead-lettering deposit at offset %d of partition %d: %v",
+		message.Offset, message.Partition, reason)
+
+	headers := append(message.Headers[:len(message.Headers):len(message.Headers)],
+		kafka.Header{Key: HeaderDeadLetterError, Value: []byte(deadLetterReason(reason))},
+		kafka.Header{Key: HeaderDeadLetterTopic, Value: []byte(message.Topic)},
+		kafka.Header{Key: HeaderDeadLetterPartition, Value: []byte(strconv.Itoa(message.Partition))},
+		kafka.Header{Key: HeaderDeadLetterOffset, Value: []byte(strconv.FormatInt(message.Offset, 10))},
+	)
+
+	return deadLetters.WriteMessages(ctx, kafka.Message{
+		Key:     message.Key,
+		Value:   message.Value,
+		Headers: headers,
+	})
+}
+
+// deadLetterReason describes why a message was dead-lettered or parked, as the problem clients of
+// the API would get for it.
+func deadLetterReason(err error) string {
+	raw, marshalErr := json.Marshal(apperror.ToProblem(err))
+	if marshalErr != nil {
+		return err.Error()
+	}
+
+	return string(raw)
+}
@@ -0,0 +1,196 @@
+package ledger
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+	"github.com/safayildirim/wallet-management-service/internal/asset"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/entity"
+	ledgermock "github.com/safayildirim/wallet-management-service/internal/ledger/mock"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/request"
+	"github.com/safayildirim/wallet-management-service/internal/wallet"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestDepositConsumer_Process(t *testing.T) {
+	valid := `{"network":"ethereum","address":"0xabc","asset":"ETH","amount":"0.25","tx_hash":"0xfeed","output_index":0}`
+	credited := &entity.ChainDeposit{
+		Network: "ethereum", TxHash: "0xfeed", WalletID: 7, Asset: "ETH", Amount: amount.New(250000000000000000),
+	}
+
+	tests := []struct {
+		name             string
+		value            string
+		serviceErrors    []error
+		deadLetterErrors []error
+		parkErrors       []error
+		cancelled        bool
+		expectDeadLetter string
+		expectParked     string
+		expectCommit     bool
+	}{
+		{
+			name:          "when deposit is credited then should commit offset",
+			value:         valid,
+			serviceErrors: []error{nil},
+			expectCommit:  true,
+		},
+		{
+			name:          "when deposit has been credited already then should commit offset",
+			value:         valid,
+			serviceErrors: []error{ErrDepositAlreadyCredited},
+			expectCommit:  true,
+		},
+		{
+			name:          "when crediting fails transiently then should retry until it succeeds",
+			value:         valid,
+			serviceErrors: []error{errors.New("connection refused"), errors.New("connection refused"), nil},
+			expectCommit:  true,
+		},
+		{
+			name:          "when no wallet holds address then should park message and commit offset",
+			value:         valid,
+			serviceErrors: []error{wallet.ErrWalletNotFound},
+			parkErrors:    []error{nil},
+			expectParked:  `{"type":"about:blank","title":"Not Found","status":404,"code":"wallet_not_found","detail":"wallet not found"}`,
+			expectCommit:  true,
+		},
+		{
+			name:          "when wallet is frozen then should park message and commit offset",
+			value:         valid,
+			serviceErrors: []error{wallet.ErrWalletFrozen},
+			parkErrors:    []error{nil},
+			expectParked:  `{"type":"about:blank","title":"Conflict","status":409,"code":"wallet_frozen","detail":"wallet is frozen"}`,
+			expectCommit:  true,
+		},
+		{
+			name:          "when asset is disabled then should park message and commit offset",
+			value:         valid,
+			serviceErrors: []error{asset.ErrAssetDisabled},
+			parkErrors:    []error{nil},
+			expectParked:  `{"type":"about:blank","title":"Bad Request","status":400,"code":"asset_disabled","detail":"asset is disabled"}`,
+			expectCommit:  true,
+		},
+		{
+			name:          "when parking fails then should retry until it succeeds",
+			value:         valid,
+			serviceErrors: []error{wallet.ErrWalletFrozen, wallet.ErrWalletFrozen},
+			parkErrors:    []error{errors.New("connection refused"), nil},
+			expectParked:  `{"type":"about:blank","title":"Conflict","status":409,"code":"wallet_frozen","detail":"wallet is frozen"}`,
+			expectCommit:  true,
+		},
+		{
+			name:             "when asset belongs to another network then should dead-letter message and commit offset",
+			value:            valid,
+			serviceErrors:    []error{ErrInvalidAsset},
+			deadLetterErrors: []error{nil},
+			expectDeadLetter: `{"type":"about:blank","title":"Bad Request","status":400,"code":"invalid_asset","detail":"invalid asset"}`,
+			expectCommit:     true,
+		},
+		{
+			name:             "when message is not json then should dead-letter message and commit offset",
+			value:            `{"network":`,
+			deadLetterErrors: []error{nil},
+			expectDeadLetter: `{"type":"about:blank","title":"Bad Request","status":400,"code":"invalid_request","detail":"invalid request: unexpected end of JSON input"}`,
+			expectCommit:     true,
+		},
+		{
+			name:             "when message is invalid then should dead-letter message and commit offset",
+			value:            `{"network":"ethereum","address":"0xabc","asset":"ETH","amount":"0.25","output_index":0}`,
+			deadLetterErrors: []error{nil},
+			expectDeadLetter: `{"type":"about:blank","title":"Bad Request","status":400,"code":"invalid_request","detail":"invalid request","errors":{"tx_hash":"cannot be blank"}}`,
+			expectCommit:     true,
+		},
+		{
+			name:             "when dead-lettering fails then should retry until it succeeds",
+			value:            `{"network":`,
+			deadLetterErrors: []error{errors.New("broker unavailable"), nil},
+			expectDeadLetter: `{"type":"about:blank","title":"Bad Request","status":400,"code":"invalid_request","detail":"invalid request: unexpected end of JSON input"}`,
+			expectCommit:     true,
+		},
+		{
+			name:          "when context is cancelled while retrying then should stop without committing offset",
+			value:         valid,
+			serviceErrors: []error{errors.New("connection refused")},
+			cancelled:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReader := ledgermock.NewMockDepositReader(t)
+			mockDeadLetters := ledgermock.NewMockDeadLetterWriter(t)
+			mockService := ledgermock.NewMockLedgerService(t)
+			mockRepository := ledgermock.NewMockLedgerRepository(t)
+			c := NewDepositConsumer(mockReader, mockDeadLetters, mockService, mockRepository, config.LedgerConfig{
+				DepositRetryBackoff: time.Millisecond, ParkedDepositInterval: time.Minute,
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			message := kafka.Message{Topic: "chain-deposits", Partition: 2, Offset: 42, Key: []byte("0xfeed"), Value: []byte(tt.value)}
+
+			for _, serviceErr := range tt.serviceErrors {
+				var mockReturn *entity.ChainDeposit
+				if serviceErr == nil {
+					mockReturn = credited
+				}
+				call := mockService.EXPECT().CreditChainDeposit(mock.Anything, mock.AnythingOfType("*request.ChainDepositRequest"))
+				if tt.cancelled {
+					call.Run(func(context.Context, *request.ChainDepositRequest) { cancel() })
+				}
+				call.Return(mockReturn, serviceErr).Once()
+			}
+			for _, deadLetterErr := range tt.deadLetterErrors {
+				mockDeadLetters.EXPECT().WriteMessages(mock.Anything, mock.Anything).RunAndReturn(
+					func(_ context.Context, messages ...kafka.Message) error {
+						assert.Len(t, messages, 1)
+						assert.Equal(t, message.Key, messages[0].Key)
+						assert.Equal(t, message.Value, messages[0].Value)
+						headers := make(map[string]string)
+						for _, header := range messages[0].Headers {
+							headers[header.Key] = string(header.Value)
+						}
+						assert.JSONEq(t, tt.expectDeadLetter, headers[HeaderDeadLetterError])
+						assert.Equal(t, "chain-deposits", headers[HeaderDeadLetterTopic])
+						assert.Equal(t, "2", headers[HeaderDeadLetterPartition])
+						assert.Equal(t, "42", headers[HeaderDeadLetterOffset])
+
+						return deadLetterErr
+					}).Once()
+			}
+			for _, parkErr := range tt.parkErrors {
+				mockRepository.EXPECT().ParkDeposit(mock.Anything, mock.Anything).RunAndReturn(
+					func(_ context.Context, deposit *entity.ParkedDeposit) error {
+						assert.Equal(t, "chain-deposits", deposit.SourceTopic)
+						assert.Equal(t, 2, deposit.SourcePartition)
+						assert.Equal(t, int64(42), deposit.SourceOffset)
+						assert.Equal(t, message.Key, deposit.Key)
+						assert.Equal(t, message.Value, deposit.Value)
+						assert.JSONEq(t, tt.expectParked, deposit.Reason)
+						assert.Equal(t, 1, deposit.Attempts)
+						assert.WithinDuration(t, time.Now().Add(time.Minute), deposit.NextAttemptAt, time.Second)
+
+						return parkErr
+					}).Once()
+			}
+			if tt.expectCommit {
+				mockReader.EXPECT().CommitMessages(mock.Anything, message).Return(nil).Once()
+			}
+
+			err := c.process(ctx, message)
+
+			if tt.cancelled {
+				assert.ErrorIs(t, err, context.Canceled)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
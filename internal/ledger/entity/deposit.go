@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"fmt"
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+	"time"
+)
+
+// ChainDeposit is a deposit detected on chain and credited to the wallet holding the receiving
+// address through a deposit transaction. An output of a chain transaction is credited at most
+// once.
+type ChainDeposit struct {
+	ID            uint          `json:"id"`
+	CreatedAt     time.Time     `json:"created_at"`
+	Network       string        `json:"network"`
+	TxHash        string        `json:"tx_hash"`
+	OutputIndex   uint          `json:"output_index"`
+	Address       string        `json:"address"`
+	Memo          string        `json:"memo"`
+	WalletID      uint          `json:"wallet_id"`
+	TransactionID uint          `json:"transaction_id"`
+	Asset         string        `json:"asset"`
+	Amount        amount.Amount `json:"amount"`
+}
+
+func (ChainDeposit) TableName() string {
+	return "ledger_chain_deposits"
+}
+
+// Reference identifies the chain output in the history of the wallet.
+func (d ChainDeposit) Reference() string {
+	return fmt.Sprintf("%s:%d", d.TxHash, d.OutputIndex)
+}
+
+// ParkedDeposit is a deposit notification that cannot be credited yet because its wallet, address
+// or asset is not in a state to receive it. It is retried until it is credited.
+type ParkedDeposit struct {
+	ID              uint      `json:"id"`
+	CreatedAt       time.Time `json:"created_at"`
+	SourceTopic     string    `json:"source_topic"`
+	SourcePartition int       `json:"source_partition"`
+	SourceOffset    int64     `json:"source_offset"`
+	Key             []byte    `json:"key"`
+	Value           []byte    `json:"value"`
+	Reason          string    `json:"reason"`
+	Attempts        int       `json:"attempts"`
+	NextAttemptAt   time.Time `json:"next_attempt_at"`
+}
+
+func (ParkedDeposit) TableName() string {
+	return "ledger_parked_deposits"
+}
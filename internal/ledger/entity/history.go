@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+const (
+	TransactionTypeTransferIn  = "transfer_in"
+	TransactionTypeTransferOut = "transfer_out"
+	TransactionTypeHold        = "hold"
+
+	// TransactionStatusCompleted transactions have been posted to the ledger.
+	TransactionStatusCompleted = "completed"
+	// TransactionStatusPending holds reserve their amount until they are captured, released or expire.
+	TransactionStatusPending = "pending"
+	// TransactionStatusCancelled holds have been released or have expired.
+	TransactionStatusCancelled = "cancelled"
+)
+
+// WalletTransaction is a single movement of an asset into or out of a wallet, as shown in
+// the wallet's history: a deposit, a withdrawal, one side of a transfer or a hold. Amount is
+// always positive; the type tells its direction. Fee is only set on the sending side of a
+// transfer. A captured hold is shown as the withdrawal it was captured into.
+type WalletTransaction struct {
+	EntryID              uint          `json:"entry_id"`
+	CreatedAt            time.Time     `json:"created_at"`
+	WalletID             uint          `json:"wallet_id"`
+	Type                 string        `json:"type"`
+	Status               string        `json:"status"`
+	Asset                string        `json:"asset"`
+	Amount               amount.Amount `json:"amount"`
+	Fee                  amount.Amount `json:"fee"`
+	CounterpartyWalletID null.Int      `json:"counterparty_wallet_id"`
+	Reference            string        `json:"reference"`
+}
+
+func (WalletTransaction) TableName() string {
+	return "wallet_transactions"
+}
+
+// TransactionFilter describes the transactions of a wallet to list or export, ordered by
+// journal entry.
+type TransactionFilter struct {
+	WalletID     uint
+	Asset        string
+	Types        []string
+	Status       string
+	CreatedFrom  *time.Time
+	CreatedTo    *time.Time
+	Descending   bool
+	Limit        int
+	AfterEntryID uint
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+const (
+	// HoldStatusHeld holds reserve their amount until they are captured, released or expire.
+	HoldStatusHeld     = "held"
+	HoldStatusCaptured = "captured"
+	HoldStatusReleased = "released"
+	HoldStatusExpired  = "expired"
+)
+
+// Hold reserves an amount of an asset of a wallet by moving it from the available to the held
+// balance. Capturing the hold debits the amount from the wallet; releasing it, or letting it
+// expire, makes the amount available again.
+type Hold struct {
+	ID            uint          `json:"id"`
+	CreatedAt     time.Time     `json:"created_at"`
+	WalletID      uint          `json:"wallet_id"`
+	Asset         string        `json:"asset"`
+	Amount        amount.Amount `json:"amount"`
+	Status        string        `json:"status"`
+	ExpiresAt     time.Time     `json:"expires_at"`
+	Reference     string        `json:"reference"`
+	EntryID       uint          `json:"entry_id"`
+	SettledAt     null.Time     `json:"settled_at"`
+	SettleEntryID null.Int      `json:"settle_entry_id"`
+	TransactionID null.Int      `json:"transaction_id"`
+}
+
+func (Hold) TableName() string {
+	return "ledger_holds"
+}
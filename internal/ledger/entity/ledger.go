@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+const (
+	// AccountKindWallet accounts hold the available assets of a wallet.
+	AccountKindWallet = "wallet"
+	// AccountKindHold accounts hold the assets of a wallet reserved by holds.
+	AccountKindHold = "hold"
+	// AccountKindExternal accounts stand for the world outside the ledger. Assets
+	// flowing in or out of the ledger are posted against them.
+	AccountKindExternal = "external"
+	// AccountKindFee accounts collect the fees charged on transfers.
+	AccountKindFee = "fee"
+)
+
+// Account holds a single asset of a wallet or of the world outside the ledger. Its
+// balance is the sum of its postings.
+type Account struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Kind      string    `json:"kind"`
+	WalletID  null.Int  `json:"wallet_id"`
+	Asset     string    `json:"asset"`
+}
+
+func (Account) TableName() string {
+	return "ledger_accounts"
+}
+
+// Key returns the natural key of the account.
+func (a Account) Key() AccountKey {
+	return AccountKey{Kind: a.Kind, WalletID: uint(a.WalletID.Int64), Asset: a.Asset}
+}
+
+// AccountKey identifies an account by what it holds. WalletID is zero unless the account
+// belongs to a wallet.
+type AccountKey struct {
+	Kind     string
+	WalletID uint
+	Asset    string
+}
+
+// WalletAccount returns the key of the account holding the asset of a wallet.
+func WalletAccount(walletID uint, asset string) AccountKey {
+	return AccountKey{Kind: AccountKindWallet, WalletID: walletID, Asset: asset}
+}
+
+// HoldAccount returns the key of the account holding the reserved asset of a wallet.
+func HoldAccount(walletID uint, asset string) AccountKey {
+	return AccountKey{Kind: AccountKindHold, WalletID: walletID, Asset: asset}
+}
+
+// ExternalAccount returns the key of the account standing for the asset outside the ledger.
+func ExternalAccount(asset string) AccountKey {
+	return AccountKey{Kind: AccountKindExternal, Asset: asset}
+}
+
+// FeeAccount returns the key of the account collecting the fees charged in the asset.
+func FeeAccount(asset string) AccountKey {
+	return AccountKey{Kind: AccountKindFee, Asset: asset}
+}
+
+// JournalEntry is an immutable record of a movement of assets between accounts. The
+// amounts of its postings add up to zero for every asset.
+type JournalEntry struct {
+	ID          uint      `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description"`
+	Postings    []Posting `json:"postings" gorm:"foreignKey:EntryID"`
+}
+
+func (JournalEntry) TableName() string {
+	return "ledger_entries"
+}
+
+// Posting credits (positive amount) or debits (negative amount) an account, in integer
+// base units of the account's asset.
+type Posting struct {
+	ID        uint          `json:"id"`
+	EntryID   uint          `json:"entry_id"`
+	AccountID uint          `json:"account_id"`
+	Amount    amount.Amount `json:"amount"`
+}
+
+func (Posting) TableName() string {
+	return "ledger_postings"
+}
+
+// PostingLine is a posting that is yet to be recorded, addressed by the key of its account.
+type PostingLine struct {
+	Account AccountKey
+	Amount  amount.Amount
+}
+
+// Balance is the amount of an asset held by a wallet, in integer base units. Held funds are
+// reserved by holds and cannot be spent until they are released; the total is the sum of both.
+type Balance struct {
+	Asset     string        `json:"asset"`
+	Available amount.Amount `json:"available"`
+	Held      amount.Amount `json:"held"`
+	Total     amount.Amount `json:"total"`
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+	"time"
+)
+
+const (
+	TransactionTypeDeposit    = "deposit"
+	TransactionTypeWithdrawal = "withdrawal"
+)
+
+// Transaction is a deposit into or a withdrawal from a wallet, backed by a journal entry
+// against the external account of the asset.
+type Transaction struct {
+	ID           uint          `json:"id"`
+	CreatedAt    time.Time     `json:"created_at"`
+	WalletID     uint          `json:"wallet_id"`
+	EntryID      uint          `json:"entry_id"`
+	Type         string        `json:"type"`
+	Asset        string        `json:"asset"`
+	Amount       amount.Amount `json:"amount"`
+	BalanceAfter amount.Amount `json:"balance_after"`
+	Reference    string        `json:"reference"`
+}
+
+func (Transaction) TableName() string {
+	return "ledger_transactions"
+}
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+	"time"
+)
+
+// Transfer moves an amount of an asset from one wallet to another within the ledger. The
+// sender pays the fee on top of the amount; it is credited to the fee account of the asset.
+type Transfer struct {
+	ID           uint          `json:"id"`
+	CreatedAt    time.Time     `json:"created_at"`
+	EntryID      uint          `json:"entry_id"`
+	FromWalletID uint          `json:"from_wallet_id"`
+	ToWalletID   uint          `json:"to_wallet_id"`
+	Asset        string        `json:"asset"`
+	Amount       amount.Amount `json:"amount"`
+	Fee          amount.Amount `json:"fee"`
+	Reference    string        `json:"reference"`
+	Postings     []Posting     `json:"postings" gorm:"-"`
+}
+
+func (Transfer) TableName() string {
+	return "ledger_transfers"
+}
@@ -0,0 +1,19 @@
+package ledger
+
+import "github.com/safayildirim/wallet-management-service/internal/apperror"
+
+var (
+	ErrInvalidAsset    = apperror.Validation("invalid_asset", "invalid asset")
+	ErrInvalidAmount   = apperror.Validation("invalid_amount", "invalid amount")
+	ErrInvalidPosting  = apperror.Validation("invalid_posting", "invalid posting")
+	ErrUnbalancedEntry = apperror.Validation("unbalanced_entry", "journal entry does not balance")
+	ErrInvalidCursor   = apperror.Validation("invalid_cursor", "invalid cursor")
+	ErrInvalidExpiry   = apperror.Validation("invalid_hold_expiry", "invalid hold expiry")
+	ErrHoldNotFound    = apperror.NotFound("hold_not_found", "hold not found")
+
+	ErrInsufficientFunds = apperror.Conflict("insufficient_funds", "insufficient funds")
+	ErrHoldSettled       = apperror.Conflict("hold_settled", "hold has already been settled")
+	ErrHoldExpired       = apperror.Conflict("hold_expired", "hold has expired")
+
+	ErrDepositAlreadyCredited = apperror.Conflict("deposit_already_credited", "deposit has already been credited")
+)
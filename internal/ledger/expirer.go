@@ -0,0 +1,67 @@
+package ledger
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"github.com/safayildirim/wallet-management-service/pkg/worker"
+	"time"
+)
+
+// HoldExpirer periodically releases holds that have passed their expiry, making their amounts
+// available again.
+type HoldExpirer struct {
+	ledgerRepository Repository
+	interval         time.Duration
+	batchSize        int
+}
+
+func NewHoldExpirer(ledgerRepository Repository, conf config.LedgerConfig) (*HoldExpirer, error) {
+	if err := worker.Validate(conf.HoldExpiryInterval, conf.HoldExpiryBatchSize); err != nil {
+		return nil, errors.Wrap(err, "invalid hold expirer config")
+	}
+
+	return &HoldExpirer{
+		ledgerRepository: ledgerRepository,
+		interval:         conf.HoldExpiryInterval,
+		batchSize:        conf.HoldExpiryBatchSize,
+	}, nil
+}
+
+// Run releases expired holds on every tick until the context is cancelled.
+func (e *HoldExpirer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		expired, err := e.expire(ctx, time.Now())
+		if err != nil {
+			logger.Zap.Sugar().Errorf("hold expiry failed: %v", err)
+		} else if expired > 0 {
+			logger.Zap.Sugar().Infof("released %d expired holds", expired)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// expire releases holds expired by the given time in batches, each in its own transaction.
+func (e *HoldExpirer) expire(ctx context.Context, now time.Time) (int64, error) {
+	var total int64
+	for {
+		expired, err := e.ledgerRepository.ExpireHolds(ctx, now, e.batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		total += expired
+		if expired < int64(e.batchSize) || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
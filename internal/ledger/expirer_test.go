@@ -0,0 +1,65 @@
+package ledger
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	ledgermock "github.com/safayildirim/wallet-management-service/internal/ledger/mock"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestHoldExpirer_Expire(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		batches       []int64
+		mockError     error
+		expectedTotal int64
+		expectErr     bool
+	}{
+		{
+			name:          "when backlog spans several batches then should keep expiring until a partial batch",
+			batches:       []int64{2, 2, 1},
+			expectedTotal: 5,
+		},
+		{
+			name:          "when nothing has expired then should expire nothing",
+			batches:       []int64{0},
+			expectedTotal: 0,
+		},
+		{
+			name:          "when repository returns an error then should stop and return error",
+			batches:       []int64{2},
+			mockError:     errors.New("repository error"),
+			expectedTotal: 2,
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := ledgermock.NewMockLedgerRepository(t)
+			e, err := NewHoldExpirer(mockRepository, config.LedgerConfig{HoldExpiryInterval: time.Minute, HoldExpiryBatchSize: 2})
+			assert.NoError(t, err)
+
+			for _, expired := range tt.batches {
+				mockRepository.EXPECT().ExpireHolds(context.Background(), now, 2).Return(expired, nil).Once()
+			}
+			if tt.mockError != nil {
+				mockRepository.EXPECT().ExpireHolds(context.Background(), now, 2).Return(0, tt.mockError).Once()
+			}
+
+			total, err := e.expire(context.Background(), now)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedTotal, total)
+		})
+	}
+}
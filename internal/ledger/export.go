@@ -0,0 +1,149 @@
+package ledger
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/entity"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/request"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	MIMETextCSV           = "text/csv"
+	MIMEApplicationNDJSON = "application/x-ndjson"
+
+	// exportFlushInterval is the number of transactions after which the export is flushed.
+	exportFlushInterval = 100
+)
+
+var csvHeader = []string{
+	"entry_id", "created_at", "wallet_id", "type", "status", "asset", "amount", "fee",
+	"counterparty_wallet_id", "reference",
+}
+
+// transactionExporter streams the transactions of a wallet to the response. The response is
+// only committed once the first transaction is written or the export is closed, so that
+// errors raised before any data has been read are still reported as usual.
+type transactionExporter struct {
+	res      *echo.Response
+	format   string
+	filename string
+	csv      *csv.Writer
+	json     *json.Encoder
+	started  bool
+	written  int
+}
+
+func newTransactionExporter(res *echo.Response, walletID uint, format string) *transactionExporter {
+	return &transactionExporter{
+		res:      res,
+		format:   format,
+		filename: fmt.Sprintf("wallet-%d-transactions.%s", walletID, format),
+	}
+}
+
+// Write appends a transaction to the export, flushing the response periodically.
+func (e *transactionExporter) Write(item *entity.WalletTransaction) error {
+	if err := e.start(); err != nil {
+		return err
+	}
+
+	var err error
+	if e.format == request.ExportFormatCSV {
+		err = e.csv.Write(csvRecord(item))
+	} else {
+		err = e.json.Encode(item)
+	}
+	if err != nil {
+		return err
+	}
+
+	e.written++
+	if e.written%exportFlushInterval == 0 {
+		return e.flush()
+	}
+
+	return nil
+}
+
+// Close completes the export, committing the response if nothing has been written yet.
+func (e *transactionExporter) Close() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+
+	return e.flush()
+}
+
+func (e *transactionExporter) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+
+	contentType := MIMEApplicationNDJSON
+	if e.format == request.ExportFormatCSV {
+		contentType = MIMETextCSV + "; charset=utf-8"
+	}
+
+	header := e.res.Header()
+	header.Set(echo.HeaderContentType, contentType)
+	header.Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", e.filename))
+	e.res.WriteHeader(http.StatusOK)
+
+	if e.format == request.ExportFormatCSV {
+		e.csv = csv.NewWriter(e.res)
+		return e.csv.Write(csvHeader)
+	}
+
+	e.json = json.NewEncoder(e.res)
+
+	return nil
+}
+
+func (e *transactionExporter) flush() error {
+	if e.csv != nil {
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return err
+		}
+	}
+	e.res.Flush()
+
+	return nil
+}
+
+// csvRecord formats a transaction as a CSV row in the order of csvHeader.
+func csvRecord(item *entity.WalletTransaction) []string {
+	var counterparty string
+	if item.CounterpartyWalletID.Valid {
+		counterparty = strconv.FormatInt(item.CounterpartyWalletID.Int64, 10)
+	}
+
+	return []string{
+		strconv.FormatUint(uint64(item.EntryID), 10),
+		item.CreatedAt.UTC().Format(time.RFC3339Nano),
+		strconv.FormatUint(uint64(item.WalletID), 10),
+		item.Type,
+		item.Status,
+		item.Asset,
+		item.Amount.String(),
+		item.Fee.String(),
+		counterparty,
+		escapeCSVFormula(item.Reference),
+	}
+}
+
+// escapeCSVFormula keeps spreadsheets from evaluating client-supplied text as a formula.
+func escapeCSVFormula(value string) string {
+	if value != "" && strings.ContainsAny(value[:1], "=+-@\t\r") {
+		return "'" + value
+	}
+
+	return value
+}
@@ -0,0 +1,321 @@
+package ledger
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/common"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/entity"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/request"
+	"net/http"
+)
+
+type Handler struct {
+	ledgerService Service
+}
+
+func NewHandler(ledgerService Service) *Handler {
+	return &Handler{ledgerService: ledgerService}
+}
+
+func (h Handler) RegisterRoutes(e *echo.Group) {
+	e.GET("/wallets/:id/balances", h.GetBalances)
+	e.POST("/wallets/:id/deposits", h.Deposit)
+	e.POST("/wallets/:id/withdrawals", h.Withdraw)
+	e.GET("/wallets/:id/transactions", h.ListTransactions)
+	e.GET("/wallets/:id/transactions/export", h.ExportTransactions)
+	e.POST("/wallets/:id/holds", h.CreateHold)
+	e.GET("/wallets/:id/holds/:hold_id", h.GetHold)
+	e.POST("/wallets/:id/holds/:hold_id/capture", h.CaptureHold)
+	e.POST("/wallets/:id/holds/:hold_id/release", h.ReleaseHold)
+	e.POST("/transfers", h.Transfer)
+}
+
+// GetBalances returns the available, held and total balances of a wallet derived from the ledger.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the balances of the wallet, one per asset, on success.
+//   - 400 Bad Request if the ID is invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) GetBalances(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	balances, err := h.ledgerService.GetBalances(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: balances})
+}
+
+// Deposit credits an amount of an asset to a wallet.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 201 Created with the recorded deposit on success.
+//   - 400 Bad Request if the ID or the request payload is invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 409 Conflict if the wallet is not active.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) Deposit(ctx echo.Context) error {
+	id, req, err := bindTransaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	transaction, err := h.ledgerService.Deposit(ctx.Request().Context(), id, req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, Response{Data: transaction})
+}
+
+// Withdraw debits an amount of an asset from a wallet.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 201 Created with the recorded withdrawal on success.
+//   - 400 Bad Request if the ID or the request payload is invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 409 Conflict if the wallet is not active or its balance is insufficient.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) Withdraw(ctx echo.Context) error {
+	id, req, err := bindTransaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	transaction, err := h.ledgerService.Withdraw(ctx.Request().Context(), id, req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, Response{Data: transaction})
+}
+
+// Transfer moves an amount of an asset from one wallet to another.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 201 Created with the recorded transfer on success.
+//   - 400 Bad Request if the request payload is invalid.
+//   - 404 Not Found if either wallet does not exist.
+//   - 409 Conflict if either wallet is not active or the balance of the sender is insufficient.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) Transfer(ctx echo.Context) error {
+	var req request.TransferRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	transfer, err := h.ledgerService.Transfer(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, Response{Data: transfer})
+}
+
+// ListTransactions retrieves a page of the history of a wallet matching the query filters.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the transactions and the cursor of the next page on success.
+//   - 400 Bad Request if the ID, the query parameters or the cursor are invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) ListTransactions(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	var req request.ListTransactionsRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	transactions, next, err := h.ledgerService.ListTransactions(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: transactions, NextCursor: next})
+}
+
+// ExportTransactions streams the whole history of a wallet matching the query filters as CSV
+// or newline-delimited JSON.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the transactions as an attachment on success.
+//   - 400 Bad Request if the ID, the format or the query parameters are invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 500 Internal Server Error for unexpected issues. Errors raised once streaming has
+//     started cut the response short instead.
+func (h Handler) ExportTransactions(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	var req request.ExportTransactionsRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	exporter := newTransactionExporter(ctx.Response(), id, req.Format)
+	err = h.ledgerService.ExportTransactions(ctx.Request().Context(), id, &req, exporter.Write)
+	if err != nil {
+		return err
+	}
+
+	return exporter.Close()
+}
+
+// CreateHold reserves an amount of an asset of a wallet.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 201 Created with the created hold on success.
+//   - 400 Bad Request if the ID, the request payload or the expiry is invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 409 Conflict if the wallet is not active or its available balance is insufficient.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) CreateHold(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	var req request.HoldRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	hold, err := h.ledgerService.CreateHold(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, Response{Data: hold})
+}
+
+// GetHold retrieves a hold of a wallet.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the hold on success.
+//   - 400 Bad Request if an ID is invalid.
+//   - 404 Not Found if the wallet or the hold does not exist.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) GetHold(ctx echo.Context) error {
+	return h.handleHold(ctx, h.ledgerService.GetHold)
+}
+
+// CaptureHold debits the amount of a hold from its wallet.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the captured hold on success.
+//   - 400 Bad Request if an ID is invalid.
+//   - 404 Not Found if the wallet or the hold does not exist.
+//   - 409 Conflict if the wallet is not active or the hold has been settled or has expired.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) CaptureHold(ctx echo.Context) error {
+	return h.handleHold(ctx, h.ledgerService.CaptureHold)
+}
+
+// ReleaseHold makes the amount of a hold available again.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the released hold on success.
+//   - 400 Bad Request if an ID is invalid.
+//   - 404 Not Found if the wallet or the hold does not exist.
+//   - 409 Conflict if the hold has been settled.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) ReleaseHold(ctx echo.Context) error {
+	return h.handleHold(ctx, h.ledgerService.ReleaseHold)
+}
+
+// handleHold parses the wallet and hold IDs and responds with the hold returned by fn.
+func (h Handler) handleHold(ctx echo.Context, fn func(context.Context, uint, uint) (*entity.Hold, error)) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	holdID, err := common.ParseIntFromString[uint](ctx.Param("hold_id"))
+	if err != nil {
+		return apperror.InvalidParam("hold_id", err)
+	}
+
+	hold, err := fn(ctx.Request().Context(), id, holdID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: hold})
+}
+
+// bindTransaction parses the wallet ID and the validated body of a deposit or withdrawal.
+func bindTransaction(ctx echo.Context) (uint, *request.TransactionRequest, error) {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return 0, nil, apperror.InvalidParam("id", err)
+	}
+
+	var req request.TransactionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return 0, nil, apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return 0, nil, apperror.InvalidRequest(err)
+	}
+
+	return id, &req, nil
+}
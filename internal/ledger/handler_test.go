@@ -0,0 +1,653 @@
+package ledger
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/entity"
+	ledgermock "github.com/safayildirim/wallet-management-service/internal/ledger/mock"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/request"
+	"github.com/safayildirim/wallet-management-service/internal/wallet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v3"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_GetBalances(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		walletID             string
+		mockService          bool
+		mockReturnData       []entity.Balance
+		mockReturnErr        error
+		expectedStatus       int
+		expectedBody         string
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when wallet exists then should return balances",
+			walletID:       "1",
+			mockService:    true,
+			mockReturnData: []entity.Balance{{Asset: "BTC", Available: amount.New(100000), Held: amount.New(50000), Total: amount.New(150000)}},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":[{"asset":"BTC","available":"100000","held":"50000","total":"150000"}]}`,
+		},
+		{
+			name:           "when wallet holds nothing then should return empty list",
+			walletID:       "1",
+			mockService:    true,
+			mockReturnData: []entity.Balance{},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":[]}`,
+		},
+		{
+			name:                 "when wallet id is invalid then should return bad request",
+			walletID:             "not-integer",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "invalid syntax",
+		},
+		{
+			name:                 "when wallet does not exist then should return not found",
+			walletID:             "1",
+			mockService:          true,
+			mockReturnErr:        wallet.ErrWalletNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "wallet not found",
+		},
+		{
+			name:                 "when service returns error then should return internal server error",
+			walletID:             "1",
+			mockService:          true,
+			mockReturnErr:        errors.New("service error"),
+			expectedStatus:       http.StatusInternalServerError,
+			expectErr:            true,
+			expectedErrorMessage: "service error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := ledgermock.NewMockLedgerService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().GetBalances(mock.Anything, uint(1)).
+					Return(tt.mockReturnData, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/wallets/:id/balances", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.walletID)
+
+			err := handler.GetBalances(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_RecordTransaction(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		withdraw             bool
+		walletID             string
+		body                 string
+		mockService          bool
+		mockReturnData       *entity.Transaction
+		mockReturnErr        error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when deposit is valid then should return deposit",
+			walletID:       "1",
+			body:           `{"asset":"BTC","amount":"0.001","reference":"tx-1"}`,
+			mockService:    true,
+			mockReturnData: &entity.Transaction{ID: 1, WalletID: 1, Type: entity.TransactionTypeDeposit, Asset: "BTC", Amount: amount.New(100000), BalanceAfter: amount.New(100000)},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "when withdrawal is valid then should return withdrawal",
+			withdraw:       true,
+			walletID:       "1",
+			body:           `{"asset":"BTC","amount":"0.0004"}`,
+			mockService:    true,
+			mockReturnData: &entity.Transaction{ID: 2, WalletID: 1, Type: entity.TransactionTypeWithdrawal, Asset: "BTC", Amount: amount.New(40000), BalanceAfter: amount.New(60000)},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:                 "when amount is not a decimal number then should return bad request",
+			walletID:             "1",
+			body:                 `{"asset":"BTC","amount":"-5"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "amount: must be a decimal number",
+		},
+		{
+			name:                 "when amount is a JSON number then should return bad request",
+			walletID:             "1",
+			body:                 `{"asset":"BTC","amount":0.5}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "Unmarshal type error",
+		},
+		{
+			name:                 "when balance is insufficient then should return conflict",
+			withdraw:             true,
+			walletID:             "1",
+			body:                 `{"asset":"BTC","amount":"0.0004"}`,
+			mockService:          true,
+			mockReturnErr:        ErrInsufficientFunds,
+			expectedStatus:       http.StatusConflict,
+			expectErr:            true,
+			expectedErrorMessage: "insufficient funds",
+		},
+		{
+			name:                 "when wallet id is invalid then should return bad request",
+			walletID:             "not-integer",
+			body:                 `{"asset":"BTC","amount":"1"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "invalid syntax",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := ledgermock.NewMockLedgerService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				if tt.withdraw {
+					mockService.EXPECT().Withdraw(mock.Anything, uint(1), mock.Anything).
+						Return(tt.mockReturnData, tt.mockReturnErr).Once()
+				} else {
+					mockService.EXPECT().Deposit(mock.Anything, uint(1), mock.Anything).
+						Return(tt.mockReturnData, tt.mockReturnErr).Once()
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/wallets/:id/deposits", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.walletID)
+
+			var err error
+			if tt.withdraw {
+				err = handler.Withdraw(ctx)
+			} else {
+				err = handler.Deposit(ctx)
+			}
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Transfer(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		body                 string
+		mockService          bool
+		mockReturnData       *entity.Transfer
+		mockReturnErr        error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when transfer is valid then should return transfer",
+			body:           `{"from_wallet_id":1,"to_wallet_id":2,"asset":"BTC","amount":"0.00001","fee":"0.0000001"}`,
+			mockService:    true,
+			mockReturnData: &entity.Transfer{ID: 1, FromWalletID: 1, ToWalletID: 2, Asset: "BTC", Amount: amount.New(1000), Fee: amount.New(10)},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:                 "when wallets are the same then should return bad request",
+			body:                 `{"from_wallet_id":1,"to_wallet_id":1,"asset":"BTC","amount":"1000"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "to_wallet_id: must differ from from_wallet_id",
+		},
+		{
+			name:                 "when fee is negative then should return bad request",
+			body:                 `{"from_wallet_id":1,"to_wallet_id":2,"asset":"BTC","amount":"1000","fee":"-1"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "fee: must be a decimal number",
+		},
+		{
+			name:                 "when sender is missing then should return bad request",
+			body:                 `{"to_wallet_id":2,"asset":"BTC","amount":"1000"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "from_wallet_id: cannot be blank",
+		},
+		{
+			name:                 "when balance is insufficient then should return conflict",
+			body:                 `{"from_wallet_id":1,"to_wallet_id":2,"asset":"BTC","amount":"1000"}`,
+			mockService:          true,
+			mockReturnErr:        ErrInsufficientFunds,
+			expectedStatus:       http.StatusConflict,
+			expectErr:            true,
+			expectedErrorMessage: "insufficient funds",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := ledgermock.NewMockLedgerService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().Transfer(mock.Anything, mock.Anything).
+					Return(tt.mockReturnData, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/transfers", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.Transfer(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_ListTransactions(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		walletID             string
+		query                string
+		mockService          bool
+		mockReturnData       []*entity.WalletTransaction
+		mockReturnNext       string
+		mockReturnErr        error
+		expectedStatus       int
+		expectedBody         string
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when filters are valid then should return transactions and next cursor",
+			walletID:       "1",
+			query:          "type=deposit&type=transfer_in&asset=BTC&limit=1",
+			mockService:    true,
+			mockReturnData: []*entity.WalletTransaction{{EntryID: 3, WalletID: 1, Type: "deposit", Status: "completed", Asset: "BTC", Amount: amount.New(5)}},
+			mockReturnNext: "next",
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"data":[{"entry_id":3,"created_at":"0001-01-01T00:00:00Z","wallet_id":1,"type":"deposit",` +
+				`"status":"completed","asset":"BTC","amount":"5","fee":"0","counterparty_wallet_id":null,"reference":""}],"next_cursor":"next"}`,
+		},
+		{
+			name:           "when pending holds are requested then should return them",
+			walletID:       "1",
+			query:          "type=hold&status=pending",
+			mockService:    true,
+			mockReturnData: []*entity.WalletTransaction{{EntryID: 4, WalletID: 1, Type: "hold", Status: "pending", Asset: "BTC", Amount: amount.New(7)}},
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"data":[{"entry_id":4,"created_at":"0001-01-01T00:00:00Z","wallet_id":1,"type":"hold",` +
+				`"status":"pending","asset":"BTC","amount":"7","fee":"0","counterparty_wallet_id":null,"reference":""}]}`,
+		},
+		{
+			name:                 "when status is unknown then should return bad request",
+			walletID:             "1",
+			query:                "status=failed",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "status: must be a valid value",
+		},
+		{
+			name:                 "when type is unknown then should return bad request",
+			walletID:             "1",
+			query:                "type=refund",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "type: (0: must be a valid value.)",
+		},
+		{
+			name:                 "when time range is inverted then should return bad request",
+			walletID:             "1",
+			query:                "created_from=2024-02-01T00:00:00Z&created_to=2024-01-01T00:00:00Z",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "created_to: must be no less than",
+		},
+		{
+			name:                 "when wallet does not exist then should return not found",
+			walletID:             "1",
+			mockService:          true,
+			mockReturnErr:        wallet.ErrWalletNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "wallet not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := ledgermock.NewMockLedgerService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().ListTransactions(mock.Anything, uint(1), mock.Anything).
+					Return(tt.mockReturnData, tt.mockReturnNext, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/wallets/:id/transactions?"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.walletID)
+
+			err := handler.ListTransactions(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_ExportTransactions(t *testing.T) {
+	e := echo.New()
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	transactions := []*entity.WalletTransaction{
+		{EntryID: 1, CreatedAt: createdAt, WalletID: 1, Type: "deposit", Status: "completed", Asset: "BTC", Amount: amount.New(100), Reference: "=HYPERLINK()"},
+		{EntryID: 2, CreatedAt: createdAt, WalletID: 1, Type: "transfer_out", Status: "completed", Asset: "BTC", Amount: amount.New(40), Fee: amount.New(1), CounterpartyWalletID: null.IntFrom(2)},
+	}
+
+	tests := []struct {
+		name                 string
+		query                string
+		mockService          bool
+		mockReturnData       []*entity.WalletTransaction
+		mockReturnErr        error
+		expectedStatus       int
+		expectedContentType  string
+		expectedBody         string
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:                "when format is csv then should stream csv with header",
+			query:               "format=csv",
+			mockService:         true,
+			mockReturnData:      transactions,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody: "entry_id,created_at,wallet_id,type,status,asset,amount,fee,counterparty_wallet_id,reference\n" +
+				"1,2024-01-02T03:04:05Z,1,deposit,completed,BTC,100,0,,'=HYPERLINK()\n" +
+				"2,2024-01-02T03:04:05Z,1,transfer_out,completed,BTC,40,1,2,\n",
+		},
+		{
+			name:                "when format is ndjson then should stream one object per line",
+			query:               "format=ndjson",
+			mockService:         true,
+			mockReturnData:      transactions[1:],
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/x-ndjson",
+			expectedBody: `{"entry_id":2,"created_at":"2024-01-02T03:04:05Z","wallet_id":1,"type":"transfer_out",` +
+				`"status":"completed","asset":"BTC","amount":"40","fee":"1","counterparty_wallet_id":2,"reference":""}` + "\n",
+		},
+		{
+			name:                "when wallet has no transactions then should stream header only",
+			query:               "format=csv",
+			mockService:         true,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody:        "entry_id,created_at,wallet_id,type,status,asset,amount,fee,counterparty_wallet_id,reference\n",
+		},
+		{
+			name:                 "when format is missing then should return bad request",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "format: cannot be blank",
+		},
+		{
+			name:                 "when wallet does not exist then should return not found",
+			query:                "format=csv",
+			mockService:          true,
+			mockReturnErr:        wallet.ErrWalletNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "wallet not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := ledgermock.NewMockLedgerService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().ExportTransactions(mock.Anything, uint(1), mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ uint, _ *request.ExportTransactionsRequest, fn func(*entity.WalletTransaction) error) error {
+						if tt.mockReturnErr != nil {
+							return tt.mockReturnErr
+						}
+						for _, item := range tt.mockReturnData {
+							if err := fn(item); err != nil {
+								return err
+							}
+						}
+						return nil
+					}).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/wallets/:id/transactions/export?"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues("1")
+
+			err := handler.ExportTransactions(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+				assert.False(t, ctx.Response().Committed)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.Equal(t, tt.expectedContentType, rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, `attachment; filename="wallet-1-transactions.`+tt.query[len("format="):]+`"`,
+					rec.Header().Get(echo.HeaderContentDisposition))
+				assert.Equal(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_Holds(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		action               string
+		holdID               string
+		body                 string
+		mockService          bool
+		mockReturnData       *entity.Hold
+		mockReturnErr        error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when hold is valid then should create hold",
+			action:         "create",
+			body:           `{"asset":"BTC","amount":"0.000001","expires_at":"2030-01-01T00:00:00Z"}`,
+			mockService:    true,
+			mockReturnData: &entity.Hold{ID: 2, WalletID: 1, Asset: "BTC", Amount: amount.New(100), Status: entity.HoldStatusHeld},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:                 "when amount is missing then should return bad request",
+			action:               "create",
+			body:                 `{"asset":"BTC"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "amount: cannot be blank",
+		},
+		{
+			name:           "when hold exists then should return hold",
+			action:         "get",
+			holdID:         "2",
+			mockService:    true,
+			mockReturnData: &entity.Hold{ID: 2, WalletID: 1, Status: entity.HoldStatusHeld},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "when hold is captured then should return captured hold",
+			action:         "capture",
+			holdID:         "2",
+			mockService:    true,
+			mockReturnData: &entity.Hold{ID: 2, WalletID: 1, Status: entity.HoldStatusCaptured},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when hold has expired then should return conflict",
+			action:               "capture",
+			holdID:               "2",
+			mockService:          true,
+			mockReturnErr:        ErrHoldExpired,
+			expectedStatus:       http.StatusConflict,
+			expectErr:            true,
+			expectedErrorMessage: "hold has expired",
+		},
+		{
+			name:           "when hold is released then should return released hold",
+			action:         "release",
+			holdID:         "2",
+			mockService:    true,
+			mockReturnData: &entity.Hold{ID: 2, WalletID: 1, Status: entity.HoldStatusReleased},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when hold does not exist then should return not found",
+			action:               "release",
+			holdID:               "2",
+			mockService:          true,
+			mockReturnErr:        ErrHoldNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "hold not found",
+		},
+		{
+			name:                 "when hold id is invalid then should return bad request",
+			action:               "get",
+			holdID:               "not-integer",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "invalid syntax",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := ledgermock.NewMockLedgerService(t)
+			handler := NewHandler(mockService)
+
+			var action echo.HandlerFunc
+			switch tt.action {
+			case "create":
+				action = handler.CreateHold
+				if tt.mockService {
+					mockService.EXPECT().CreateHold(mock.Anything, uint(1), mock.Anything).
+						Return(tt.mockReturnData, tt.mockReturnErr).Once()
+				}
+			case "get":
+				action = handler.GetHold
+				if tt.mockService {
+					mockService.EXPECT().GetHold(mock.Anything, uint(1), uint(2)).
+						Return(tt.mockReturnData, tt.mockReturnErr).Once()
+				}
+			case "capture":
+				action = handler.CaptureHold
+				if tt.mockService {
+					mockService.EXPECT().CaptureHold(mock.Anything, uint(1), uint(2)).
+						Return(tt.mockReturnData, tt.mockReturnErr).Once()
+				}
+			case "release":
+				action = handler.ReleaseHold
+				if tt.mockService {
+					mockService.EXPECT().ReleaseHold(mock.Anything, uint(1), uint(2)).
+						Return(tt.mockReturnData, tt.mockReturnErr).Once()
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/wallets/:id/holds", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "hold_id")
+			ctx.SetParamValues("1", tt.holdID)
+
+			err := action(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,712 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/entity"
+	"github.com/safayildirim/wallet-management-service/internal/wallet"
+	walletentity "github.com/safayildirim/wallet-management-service/internal/wallet/entity"
+	"gopkg.in/guregu/null.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"slices"
+	"time"
+)
+
+type Repository interface {
+	PostEntry(ctx context.Context, description string, lines []entity.PostingLine) (*entity.JournalEntry, error)
+	RecordTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error)
+	RecordTransfer(ctx context.Context, transfer *entity.Transfer) (*entity.Transfer, error)
+	RecordChainDeposit(ctx context.Context, deposit *entity.ChainDeposit) (*entity.ChainDeposit, error)
+	GetBalances(ctx context.Context, walletID uint) ([]entity.Balance, error)
+	ListWalletTransactions(ctx context.Context, filter entity.TransactionFilter) ([]*entity.WalletTransaction, error)
+	StreamWalletTransactions(ctx context.Context, filter entity.TransactionFilter, fn func(*entity.WalletTransaction) error) error
+	CreateHold(ctx context.Context, hold *entity.Hold) (*entity.Hold, error)
+	GetHold(ctx context.Context, walletID, holdID uint) (*entity.Hold, error)
+	CaptureHold(ctx context.Context, walletID, holdID uint, now time.Time) (*entity.Hold, error)
+	ReleaseHold(ctx context.Context, walletID, holdID uint, now time.Time) (*entity.Hold, error)
+	ExpireHolds(ctx context.Context, now time.Time, limit int) (int64, error)
+	ParkDeposit(ctx context.Context, deposit *entity.ParkedDeposit) error
+	ClaimParkedDeposits(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.ParkedDeposit, error)
+	UpdateParkedDeposit(ctx context.Context, deposit *entity.ParkedDeposit) error
+	DeleteParkedDeposit(ctx context.Context, id uint) error
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+// PostEntry records a journal entry and its postings in a single transaction, opening the
+// accounts it refers to on first use.
+func (r *repository) PostEntry(ctx context.Context, description string, lines []entity.PostingLine) (*entity.JournalEntry, error) {
+	var entry *entity.JournalEntry
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		entry, err = postEntry(tx, description, lines)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// RecordTransaction moves the amount of the transaction between the wallet and the outside
+// world, recording both the journal entry and the transaction. The wallet is share-locked so
+// that its status cannot change meanwhile, and its account is locked so that concurrent
+// withdrawals cannot overdraw it.
+func (r *repository) RecordTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return recordTransaction(tx, transaction)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// RecordChainDeposit credits a deposit detected on chain to its wallet, recording the deposit
+// transaction and the chain output it came from in a single transaction. An output that has
+// been credited already is rejected, so that a redelivered notification is credited only once.
+func (r *repository) RecordChainDeposit(ctx context.Context, deposit *entity.ChainDeposit) (*entity.ChainDeposit, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var credited int64
+		err := tx.Model(&entity.ChainDeposit{}).
+			Where("network = ? AND tx_hash = ? AND output_index = ?", deposit.Network, deposit.TxHash, deposit.OutputIndex).
+			Count(&credited).Error
+		if err != nil {
+			return err
+		}
+
+		if credited > 0 {
+			return ErrDepositAlreadyCredited
+		}
+
+		transaction := &entity.Transaction{
+			WalletID:  deposit.WalletID,
+			Type:      entity.TransactionTypeDeposit,
+			Asset:     deposit.Asset,
+			Amount:    deposit.Amount,
+			Reference: deposit.Reference(),
+		}
+		if err := recordTransaction(tx, transaction); err != nil {
+			return err
+		}
+
+		// A concurrent delivery of the same output may have been credited meanwhile; the unique
+		// key makes this insert wait for it and roll the duplicate back.
+		deposit.TransactionID = transaction.ID
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(deposit)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return ErrDepositAlreadyCredited
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deposit, nil
+}
+
+// GetBalances sums the postings of every account of the wallet, one balance per asset.
+func (r *repository) GetBalances(ctx context.Context, walletID uint) ([]entity.Balance, error) {
+	balances := make([]entity.Balance, 0)
+	err := r.db.WithContext(ctx).Table("ledger_accounts AS a").
+		Select("a.asset, "+
+			"COALESCE(SUM(p.amount) FILTER (WHERE a.kind = ?), 0) AS available, "+
+			"COALESCE(SUM(p.amount) FILTER (WHERE a.kind = ?), 0) AS held, "+
+			"COALESCE(SUM(p.amount), 0) AS total",
+			entity.AccountKindWallet, entity.AccountKindHold).
+		Joins("LEFT JOIN ledger_postings AS p ON p.account_id = a.id").
+		Where("a.kind IN ? AND a.wallet_id = ?", []string{entity.AccountKindWallet, entity.AccountKindHold}, walletID).
+		Group("a.asset").
+		Order("a.asset").
+		Scan(&balances).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// RecordTransfer moves the amount of the transfer from one wallet to another and the fee, if
+// any, to the fee account, recording both the journal entry and the transfer. Wallets and
+// accounts are locked in ascending ID order, so that concurrent transfers in opposite
+// directions cannot deadlock.
+func (r *repository) RecordTransfer(ctx context.Context, transfer *entity.Transfer) (*entity.Transfer, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockWallets(tx, transfer.FromWalletID, transfer.ToWalletID); err != nil {
+			return err
+		}
+
+		fromAccount := entity.WalletAccount(transfer.FromWalletID, transfer.Asset)
+		fromAccountID, err := openAccount(tx, fromAccount)
+		if err != nil {
+			return err
+		}
+
+		toAccount := entity.WalletAccount(transfer.ToWalletID, transfer.Asset)
+		toAccountID, err := openAccount(tx, toAccount)
+		if err != nil {
+			return err
+		}
+
+		if err := lockAccounts(tx, fromAccountID, toAccountID); err != nil {
+			return err
+		}
+
+		total := transfer.Amount.Add(transfer.Fee)
+		balance, err := accountBalance(tx, fromAccountID)
+		if err != nil {
+			return err
+		}
+
+		if balance.Cmp(total) < 0 {
+			return ErrInsufficientFunds.WithFields(map[string]string{
+				"amount": fmt.Sprintf("amount and fee exceed the available balance of %s", balance),
+			})
+		}
+
+		lines := []entity.PostingLine{
+			{Account: fromAccount, Amount: total.Neg()},
+			{Account: toAccount, Amount: transfer.Amount},
+		}
+		if transfer.Fee.Sign() > 0 {
+			lines = append(lines, entity.PostingLine{Account: entity.FeeAccount(transfer.Asset), Amount: transfer.Fee})
+		}
+
+		entry, err := postEntry(tx, "transfer", lines)
+		if err != nil {
+			return err
+		}
+
+		transfer.EntryID = entry.ID
+		transfer.Postings = entry.Postings
+
+		return tx.Omit("Postings").Create(transfer).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// ListWalletTransactions returns a page of the history of a wallet.
+func (r *repository) ListWalletTransactions(ctx context.Context, filter entity.TransactionFilter) ([]*entity.WalletTransaction, error) {
+	items := make([]*entity.WalletTransaction, 0)
+	err := r.transactionQuery(ctx, filter).Limit(filter.Limit).Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// StreamWalletTransactions calls fn for every transaction of a wallet matching the filter,
+// reading them row by row rather than loading the whole history into memory. Streaming
+// stops at the first error returned by fn.
+func (r *repository) StreamWalletTransactions(ctx context.Context, filter entity.TransactionFilter, fn func(*entity.WalletTransaction) error) error {
+	query := r.transactionQuery(ctx, filter)
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item entity.WalletTransaction
+		if err := query.ScanRows(rows, &item); err != nil {
+			return err
+		}
+
+		if err := fn(&item); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// transactionQuery selects the history of a wallet matching the filter in entry order.
+func (r *repository) transactionQuery(ctx context.Context, filter entity.TransactionFilter) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&entity.WalletTransaction{}).Where("wallet_id = ?", filter.WalletID)
+
+	if filter.Asset != "" {
+		query = query.Where("asset = ?", filter.Asset)
+	}
+	if len(filter.Types) > 0 {
+		query = query.Where("type IN ?", filter.Types)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("created_at < ?", *filter.CreatedTo)
+	}
+
+	// Keyset pagination: entries are unique within the history of a wallet.
+	op, direction := ">", "ASC"
+	if filter.Descending {
+		op, direction = "<", "DESC"
+	}
+	if filter.AfterEntryID != 0 {
+		query = query.Where("entry_id "+op+" ?", filter.AfterEntryID)
+	}
+
+	return query.Order("entry_id " + direction)
+}
+
+// CreateHold moves the amount of the hold from the available to the held balance of the wallet,
+// recording both the journal entry and the hold. The wallet has to be active.
+func (r *repository) CreateHold(ctx context.Context, hold *entity.Hold) (*entity.Hold, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockWallets(tx, hold.WalletID); err != nil {
+			return err
+		}
+
+		walletAccount := entity.WalletAccount(hold.WalletID, hold.Asset)
+		walletAccountID, err := openAccount(tx, walletAccount)
+		if err != nil {
+			return err
+		}
+
+		if err := lockAccounts(tx, walletAccountID); err != nil {
+			return err
+		}
+
+		balance, err := accountBalance(tx, walletAccountID)
+		if err != nil {
+			return err
+		}
+
+		if balance.Cmp(hold.Amount) < 0 {
+			return ErrInsufficientFunds.WithFields(map[string]string{
+				"amount": fmt.Sprintf("exceeds the available balance of %s", balance),
+			})
+		}
+
+		entry, err := postEntry(tx, "hold", []entity.PostingLine{
+			{Account: walletAccount, Amount: hold.Amount.Neg()},
+			{Account: entity.HoldAccount(hold.WalletID, hold.Asset), Amount: hold.Amount},
+		})
+		if err != nil {
+			return err
+		}
+
+		hold.EntryID = entry.ID
+		hold.Status = entity.HoldStatusHeld
+
+		return tx.Create(hold).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+// GetHold retrieves a hold of a wallet.
+func (r *repository) GetHold(ctx context.Context, walletID, holdID uint) (*entity.Hold, error) {
+	var hold entity.Hold
+	err := r.db.WithContext(ctx).Where("id = ? AND wallet_id = ?", holdID, walletID).Take(&hold).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrHoldNotFound
+		}
+		return nil, err
+	}
+
+	return &hold, nil
+}
+
+// CaptureHold debits the amount of a hold from the held balance of the wallet to the outside
+// world and records it as a withdrawal. The wallet has to be active and the hold unexpired.
+func (r *repository) CaptureHold(ctx context.Context, walletID, holdID uint, now time.Time) (*entity.Hold, error) {
+	var hold *entity.Hold
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		hold, err = lockHold(tx, walletID, holdID)
+		if err != nil {
+			return err
+		}
+
+		if !hold.ExpiresAt.After(now) {
+			return ErrHoldExpired
+		}
+
+		if err := lockWallets(tx, walletID); err != nil {
+			return err
+		}
+
+		// The available balance does not change, but it is reported with the withdrawal.
+		walletAccountID, err := openAccount(tx, entity.WalletAccount(walletID, hold.Asset))
+		if err != nil {
+			return err
+		}
+
+		if err := lockAccounts(tx, walletAccountID); err != nil {
+			return err
+		}
+
+		balance, err := accountBalance(tx, walletAccountID)
+		if err != nil {
+			return err
+		}
+
+		entry, err := postEntry(tx, "capture", []entity.PostingLine{
+			{Account: entity.HoldAccount(walletID, hold.Asset), Amount: hold.Amount.Neg()},
+			{Account: entity.ExternalAccount(hold.Asset), Amount: hold.Amount},
+		})
+		if err != nil {
+			return err
+		}
+
+		transaction := entity.Transaction{
+			WalletID:     walletID,
+			EntryID:      entry.ID,
+			Type:         entity.TransactionTypeWithdrawal,
+			Asset:        hold.Asset,
+			Amount:       hold.Amount,
+			BalanceAfter: balance,
+			Reference:    hold.Reference,
+		}
+		if err := tx.Create(&transaction).Error; err != nil {
+			return err
+		}
+
+		hold.TransactionID = null.IntFrom(int64(transaction.ID))
+
+		return settleHold(tx, hold, entity.HoldStatusCaptured, entry.ID, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+// ReleaseHold moves the amount of a hold back to the available balance of the wallet. Unlike
+// capturing, releasing works on wallets that are not active and on expired holds.
+func (r *repository) ReleaseHold(ctx context.Context, walletID, holdID uint, now time.Time) (*entity.Hold, error) {
+	var hold *entity.Hold
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		hold, err = lockHold(tx, walletID, holdID)
+		if err != nil {
+			return err
+		}
+
+		return releaseHold(tx, hold, entity.HoldStatusReleased, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+// ExpireHolds releases up to limit holds that expired by the given time. Holds locked by
+// concurrent captures or releases are skipped; they are settled by those requests.
+func (r *repository) ExpireHolds(ctx context.Context, now time.Time, limit int) (int64, error) {
+	var expired int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var holds []*entity.Hold
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND expires_at <= ?", entity.HoldStatusHeld, now).
+			Order("id").Limit(limit).Find(&holds).Error
+		if err != nil {
+			return err
+		}
+
+		for _, hold := range holds {
+			if err := releaseHold(tx, hold, entity.HoldStatusExpired, now); err != nil {
+				return err
+			}
+		}
+		expired = int64(len(holds))
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return expired, nil
+}
+
+// ParkDeposit sets a deposit notification aside to be retried. A notification that has been
+// parked already is left as it is.
+func (r *repository) ParkDeposit(ctx context.Context, deposit *entity.ParkedDeposit) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(deposit).Error
+}
+
+// ClaimParkedDeposits returns up to limit parked deposits that are due by the given time, oldest
+// due first. They are not handed out again until the lease has passed, so that concurrent
+// workers do not retry the same deposit and one that could not be settled is retried later.
+func (r *repository) ClaimParkedDeposits(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.ParkedDeposit, error) {
+	items := make([]*entity.ParkedDeposit, 0)
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE ledger_parked_deposits SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM ledger_parked_deposits
+			WHERE next_attempt_at <= ?
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), now, limit,
+	).Scan(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// UpdateParkedDeposit stores the outcome of a retry of a parked deposit that still cannot be
+// credited.
+func (r *repository) UpdateParkedDeposit(ctx context.Context, deposit *entity.ParkedDeposit) error {
+	return r.db.WithContext(ctx).Model(deposit).Select("reason", "attempts", "next_attempt_at").Updates(deposit).Error
+}
+
+// DeleteParkedDeposit removes a parked deposit once it has been credited or dead-lettered.
+func (r *repository) DeleteParkedDeposit(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&entity.ParkedDeposit{}, id).Error
+}
+
+// lockHold locks a hold of a wallet for update and makes sure it has not been settled yet.
+func lockHold(tx *gorm.DB, walletID, holdID uint) (*entity.Hold, error) {
+	var hold entity.Hold
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND wallet_id = ?", holdID, walletID).Take(&hold).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrHoldNotFound
+		}
+		return nil, err
+	}
+
+	if hold.Status != entity.HoldStatusHeld {
+		return nil, ErrHoldSettled.WithFields(map[string]string{"status": hold.Status})
+	}
+
+	return &hold, nil
+}
+
+// releaseHold moves the amount of a locked hold back to the available balance and settles the
+// hold with the given status.
+func releaseHold(tx *gorm.DB, hold *entity.Hold, status string, now time.Time) error {
+	entry, err := postEntry(tx, "release", []entity.PostingLine{
+		{Account: entity.HoldAccount(hold.WalletID, hold.Asset), Amount: hold.Amount.Neg()},
+		{Account: entity.WalletAccount(hold.WalletID, hold.Asset), Amount: hold.Amount},
+	})
+	if err != nil {
+		return err
+	}
+
+	return settleHold(tx, hold, status, entry.ID, now)
+}
+
+// settleHold records the outcome of a locked hold.
+func settleHold(tx *gorm.DB, hold *entity.Hold, status string, entryID uint, now time.Time) error {
+	hold.Status = status
+	hold.SettledAt = null.TimeFrom(now)
+	hold.SettleEntryID = null.IntFrom(int64(entryID))
+
+	return tx.Model(hold).Select("status", "settled_at", "settle_entry_id", "transaction_id").Updates(hold).Error
+}
+
+// postEntry records a journal entry and its postings within the given transaction.
+func postEntry(tx *gorm.DB, description string, lines []entity.PostingLine) (*entity.JournalEntry, error) {
+	accounts := make(map[entity.AccountKey]uint, len(lines))
+	for _, line := range lines {
+		if _, ok := accounts[line.Account]; ok {
+			continue
+		}
+
+		id, err := openAccount(tx, line.Account)
+		if err != nil {
+			return nil, err
+		}
+		accounts[line.Account] = id
+	}
+
+	entry := entity.JournalEntry{Description: description, Postings: make([]entity.Posting, 0, len(lines))}
+	if err := tx.Omit("Postings").Create(&entry).Error; err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		entry.Postings = append(entry.Postings, entity.Posting{
+			EntryID:   entry.ID,
+			AccountID: accounts[line.Account],
+			Amount:    line.Amount,
+		})
+	}
+
+	// The database checks that the entry balances when the transaction commits.
+	if err := tx.Create(&entry.Postings).Error; err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// recordTransaction moves the amount of a deposit or withdrawal between the wallet and the
+// outside world within the given transaction.
+func recordTransaction(tx *gorm.DB, transaction *entity.Transaction) error {
+	if err := lockWallets(tx, transaction.WalletID); err != nil {
+		return err
+	}
+
+	walletAccount := entity.WalletAccount(transaction.WalletID, transaction.Asset)
+	accountID, err := openAccount(tx, walletAccount)
+	if err != nil {
+		return err
+	}
+
+	if err := lockAccounts(tx, accountID); err != nil {
+		return err
+	}
+
+	balance, err := accountBalance(tx, accountID)
+	if err != nil {
+		return err
+	}
+
+	change := transaction.Amount
+	if transaction.Type == entity.TransactionTypeWithdrawal {
+		if balance.Cmp(change) < 0 {
+			return ErrInsufficientFunds.WithFields(map[string]string{
+				"amount": fmt.Sprintf("exceeds the available balance of %s", balance),
+			})
+		}
+		change = change.Neg()
+	}
+
+	entry, err := postEntry(tx, transaction.Type, []entity.PostingLine{
+		{Account: entity.ExternalAccount(transaction.Asset), Amount: change.Neg()},
+		{Account: walletAccount, Amount: change},
+	})
+	if err != nil {
+		return err
+	}
+
+	transaction.EntryID = entry.ID
+	transaction.BalanceAfter = balance.Add(change)
+
+	return tx.Create(transaction).Error
+}
+
+// lockWallets share-locks the wallets in ascending ID order and makes sure they are active.
+// When several wallets are locked, errors name the offending wallet.
+func lockWallets(tx *gorm.DB, walletIDs ...uint) error {
+	ids := slices.Clone(walletIDs)
+	slices.Sort(ids)
+	for _, id := range slices.Compact(ids) {
+		err := lockWallet(tx, id)
+		if err == nil {
+			continue
+		}
+
+		var appErr *apperror.Error
+		if len(ids) > 1 && errors.As(err, &appErr) {
+			return appErr.WithFields(map[string]string{"wallet_id": fmt.Sprint(id)})
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// lockWallet share-locks an existing wallet and makes sure it is active.
+func lockWallet(tx *gorm.DB, walletID uint) error {
+	var statuses []string
+	err := tx.Table("wallets").Clauses(clause.Locking{Strength: "SHARE"}).
+		Where("id = ? AND deleted_at IS NULL", walletID).
+		Pluck("status", &statuses).Error
+	if err != nil {
+		return err
+	}
+
+	if len(statuses) == 0 {
+		return wallet.ErrWalletNotFound
+	}
+
+	switch statuses[0] {
+	case walletentity.StatusActive:
+		return nil
+	case walletentity.StatusFrozen:
+		return wallet.ErrWalletFrozen
+	case walletentity.StatusClosed:
+		return wallet.ErrWalletClosed
+	default:
+		return wallet.ErrWalletNotActive
+	}
+}
+
+// lockAccounts locks the accounts for update in ascending ID order.
+func lockAccounts(tx *gorm.DB, accountIDs ...uint) error {
+	ids := slices.Clone(accountIDs)
+	slices.Sort(ids)
+
+	var locked []uint
+	return tx.Model(&entity.Account{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", slices.Compact(ids)).Order("id").Pluck("id", &locked).Error
+}
+
+// accountBalance sums the postings of an account.
+func accountBalance(tx *gorm.DB, accountID uint) (amount.Amount, error) {
+	var balance amount.Amount
+	err := tx.Model(&entity.Posting{}).Select("COALESCE(SUM(amount), 0)").
+		Where("account_id = ?", accountID).Row().Scan(&balance)
+	if err != nil {
+		return amount.Amount{}, err
+	}
+
+	return balance, nil
+}
+
+// openAccount returns the ID of the account with the given key, creating the account if needed.
+func openAccount(tx *gorm.DB, key entity.AccountKey) (uint, error) {
+	account := entity.Account{Kind: key.Kind, Asset: key.Asset}
+	if key.WalletID != 0 {
+		account.WalletID = null.IntFrom(int64(key.WalletID))
+	}
+
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&account).Error; err != nil {
+		return 0, err
+	}
+
+	// The account existed already
+	if account.ID == 0 {
+		err := tx.Where("kind = ? AND wallet_id IS NOT DISTINCT FROM ? AND asset = ?", account.Kind, account.WalletID, account.Asset).
+			Take(&account).Error
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return account.ID, nil
+}
@@ -0,0 +1,35 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+)
+
+const MaxTxHashLength = 128
+
+// ChainDepositRequest credits a deposit detected on chain to the wallet holding the receiving
+// address. The deposit is identified by the hash of its chain transaction and the index of the
+// output within it; the amount is a decimal string in whole units of the asset.
+type ChainDepositRequest struct {
+	Network     string         `json:"network"`
+	Address     string         `json:"address"`
+	Memo        string         `json:"memo"`
+	Asset       string         `json:"asset"`
+	Amount      amount.Decimal `json:"amount"`
+	TxHash      string         `json:"tx_hash"`
+	OutputIndex *int           `json:"output_index"`
+}
+
+func (r ChainDepositRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Network, validation.Required),
+		validation.Field(&r.Address, validation.Required),
+		validation.Field(&r.Asset, validation.Required),
+		validation.Field(&r.Amount, validation.Required, validation.Match(amountPattern).Error("must be a decimal number")),
+		validation.Field(&r.TxHash, validation.Required, validation.Length(1, MaxTxHashLength)),
+		validation.Field(&r.OutputIndex, validation.NotNil, validation.Min(0)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "chain deposit validation error")
+}
@@ -0,0 +1,78 @@
+package request
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/entity"
+)
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+	MaxTypeFilters   = 5
+
+	ExportFormatCSV    = "csv"
+	ExportFormatNDJSON = "ndjson"
+)
+
+// TransactionFilterRequest holds the filters shared by listing and exporting the transactions
+// of a wallet.
+type TransactionFilterRequest struct {
+	Asset       string     `json:"asset" query:"asset"`
+	Type        []string   `json:"type" query:"type"`
+	Status      string     `json:"status" query:"status"`
+	CreatedFrom *time.Time `json:"created_from" query:"created_from"`
+	CreatedTo   *time.Time `json:"created_to" query:"created_to"`
+	Order       string     `json:"order" query:"order"`
+}
+
+func (r *TransactionFilterRequest) fields() []*validation.FieldRules {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Type, validation.Length(0, MaxTypeFilters), validation.Each(validation.In(
+			entity.TransactionTypeDeposit, entity.TransactionTypeWithdrawal,
+			entity.TransactionTypeTransferIn, entity.TransactionTypeTransferOut, entity.TransactionTypeHold,
+		))),
+		validation.Field(&r.Status, validation.In(
+			entity.TransactionStatusCompleted, entity.TransactionStatusPending, entity.TransactionStatusCancelled,
+		)),
+		validation.Field(&r.Order, validation.In("asc", "desc")),
+	}
+
+	if r.CreatedFrom != nil && r.CreatedTo != nil {
+		fields = append(fields, validation.Field(&r.CreatedTo, validation.Min(*r.CreatedFrom)))
+	}
+
+	return fields
+}
+
+// ListTransactionsRequest retrieves a page of the transactions of a wallet, newest first
+// unless ordered otherwise.
+type ListTransactionsRequest struct {
+	TransactionFilterRequest
+	Limit  int    `json:"limit" query:"limit"`
+	Cursor string `json:"cursor" query:"cursor"`
+}
+
+func (r ListTransactionsRequest) Validate() error {
+	fields := append(r.fields(),
+		validation.Field(&r.Limit, validation.Min(0), validation.Max(MaxListLimit)),
+	)
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "transaction list validation error")
+}
+
+// ExportTransactionsRequest streams every matching transaction of a wallet in the given format.
+type ExportTransactionsRequest struct {
+	TransactionFilterRequest
+	Format string `json:"format" query:"format"`
+}
+
+func (r ExportTransactionsRequest) Validate() error {
+	fields := append(r.fields(),
+		validation.Field(&r.Format, validation.Required, validation.In(ExportFormatCSV, ExportFormatNDJSON)),
+	)
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "transaction export validation error")
+}
@@ -0,0 +1,29 @@
+package request
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+)
+
+// HoldRequest reserves an amount of an asset of a wallet until the hold is captured, released
+// or expires. Without an expiry the hold expires after the default hold TTL. The amount is a
+// decimal string in whole units of the asset.
+type HoldRequest struct {
+	Asset     string         `json:"asset"`
+	Amount    amount.Decimal `json:"amount"`
+	ExpiresAt *time.Time     `json:"expires_at"`
+	Reference string         `json:"reference"`
+}
+
+func (r HoldRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Asset, validation.Required),
+		validation.Field(&r.Amount, validation.Required, validation.Match(amountPattern).Error("must be a decimal number")),
+		validation.Field(&r.Reference, validation.Length(0, MaxReferenceLength)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "hold validation error")
+}
@@ -0,0 +1,32 @@
+package request
+
+import (
+	"regexp"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+)
+
+const MaxReferenceLength = 255
+
+// amountPattern matches decimal amounts; whether the precision fits the asset is up to the service.
+var amountPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// TransactionRequest deposits an amount of an asset into a wallet or withdraws it from one.
+// The amount is a decimal string in whole units of the asset, e.g. "0.5" BTC.
+type TransactionRequest struct {
+	Asset     string         `json:"asset"`
+	Amount    amount.Decimal `json:"amount"`
+	Reference string         `json:"reference"`
+}
+
+func (r TransactionRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Asset, validation.Required),
+		validation.Field(&r.Amount, validation.Required, validation.Match(amountPattern).Error("must be a decimal number")),
+		validation.Field(&r.Reference, validation.Length(0, MaxReferenceLength)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "transaction validation error")
+}
@@ -0,0 +1,32 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+)
+
+// TransferRequest moves an amount of an asset from one wallet to another, charging the
+// sender an optional fee on top. Both are decimal strings in whole units of the asset.
+type TransferRequest struct {
+	FromWalletID uint           `json:"from_wallet_id"`
+	ToWalletID   uint           `json:"to_wallet_id"`
+	Asset        string         `json:"asset"`
+	Amount       amount.Decimal `json:"amount"`
+	Fee          amount.Decimal `json:"fee"`
+	Reference    string         `json:"reference"`
+}
+
+func (r TransferRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.FromWalletID, validation.Required),
+		validation.Field(&r.ToWalletID, validation.Required,
+			validation.NotIn(r.FromWalletID).Error("must differ from from_wallet_id")),
+		validation.Field(&r.Asset, validation.Required),
+		validation.Field(&r.Amount, validation.Required, validation.Match(amountPattern).Error("must be a decimal number")),
+		validation.Field(&r.Fee, validation.Match(amountPattern).Error("must be a decimal number")),
+		validation.Field(&r.Reference, validation.Length(0, MaxReferenceLength)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "transfer validation error")
+}
@@ -0,0 +1,135 @@
+package ledger
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/entity"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"github.com/safayildirim/wallet-management-service/pkg/worker"
+	"github.com/segmentio/kafka-go"
+	"time"
+)
+
+// DepositRetrier periodically retries the deposits parked by the DepositConsumer, crediting those
+// whose wallet, address or asset has become able to receive them since.
+type DepositRetrier struct {
+	ledgerRepository Repository
+	ledgerService    Service
+	deadLetters      DeadLetterWriter
+	interval         time.Duration
+	batchSize        int
+	maxBackoff       time.Duration
+}
+
+func NewDepositRetrier(ledgerRepository Repository, ledgerService Service, deadLetters DeadLetterWriter, conf config.LedgerConfig) (*DepositRetrier, error) {
+	if err := worker.Validate(conf.ParkedDepositInterval, conf.ParkedDepositBatchSize); err != nil {
+		return nil, errors.Wrap(err, "invalid deposit retrier config")
+	}
+
+	return &DepositRetrier{
+		ledgerRepository: ledgerRepository,
+		ledgerService:    ledgerService,
+		deadLetters:      deadLetters,
+		interval:         conf.ParkedDepositInterval,
+		batchSize:        conf.ParkedDepositBatchSize,
+		maxBackoff:       max(conf.ParkedDepositMaxBackoff, conf.ParkedDepositInterval),
+	}, nil
+}
+
+// Run retries due parked deposits on every tick until the context is cancelled, then closes the
+// dead-letter writer.
+func (r *DepositRetrier) Run(ctx context.Context) error {
+	defer r.deadLetters.Close()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		credited, err := r.retry(ctx, time.Now())
+		if err != nil {
+			logger.Zap.Sugar().Errorf("parked deposit retry failed: %v", err)
+		} else if credited > 0 {
+			logger.Zap.Sugar().Infof("credited %d parked deposits", credited)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// retry retries the parked deposits due by the given time in batches and returns the number of
+// them that were credited.
+func (r *DepositRetrier) retry(ctx context.Context, now time.Time) (int, error) {
+	var total int
+	for {
+		deposits, err := r.ledgerRepository.ClaimParkedDeposits(ctx, now, r.interval, r.batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		for _, deposit := range deposits {
+			credited, err := r.attempt(ctx, deposit, now)
+			if err != nil {
+				// The deposit is retried once its claim runs out.
+				logger.Zap.Sugar().Errorf("retrying parked deposit %d failed: %v", deposit.ID, err)
+				continue
+			}
+			if credited {
+				total++
+			}
+		}
+
+		if len(deposits) < r.batchSize || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
+
+// attempt credits a parked deposit and removes it once it has been settled: credited, found
+// credited already or dead-lettered. A deposit that still cannot be credited is retried after a
+// backoff doubling with every attempt. Only failures worth retrying soon are returned.
+func (r *DepositRetrier) attempt(ctx context.Context, deposit *entity.ParkedDeposit, now time.Time) (bool, error) {
+	message := kafka.Message{
+		Topic:     deposit.SourceTopic,
+		Partition: deposit.SourcePartition,
+		Offset:    deposit.SourceOffset,
+		Key:       deposit.Key,
+		Value:     deposit.Value,
+	}
+
+	credited, err := creditDeposit(ctx, r.ledgerService, message)
+	switch {
+	case err == nil:
+		logger.Zap.Sugar().Infof("credited parked deposit %s of %s %s to wallet %d",
+			credited.Reference(), credited.Amount, credited.Asset, credited.WalletID)
+	case errors.Is(err, ErrDepositAlreadyCredited):
+	case isPendingDeposit(err):
+		deposit.Attempts++
+		deposit.Reason = deadLetterReason(err)
+		deposit.NextAttemptAt = now.Add(r.backoff(deposit.Attempts))
+		return false, r.ledgerRepository.UpdateParkedDeposit(ctx, deposit)
+	case apperror.KindOf(err) != apperror.KindInternal:
+		if err := deadLetter(ctx, r.deadLetters, message, err); err != nil {
+			return false, err
+		}
+	default:
+		return false, err
+	}
+
+	return err == nil, r.ledgerRepository.DeleteParkedDeposit(ctx, deposit.ID)
+}
+
+// backoff returns the delay before the next retry of a deposit parked the given number of times.
+func (r *DepositRetrier) backoff(attempts int) time.Duration {
+	delay := r.interval
+	for i := 1; i < attempts && delay < r.maxBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, r.maxBackoff)
+}
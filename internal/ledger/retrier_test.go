@@ -0,0 +1,144 @@
+package ledger
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/entity"
+	ledgermock "github.com/safayildirim/wallet-management-service/internal/ledger/mock"
+	"github.com/safayildirim/wallet-management-service/internal/wallet"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestDepositRetrier_Retry(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	valid := `{"network":"ethereum","address":"0xabc","asset":"ETH","amount":"0.25","tx_hash":"0xfeed","output_index":0}`
+	credited := &entity.ChainDeposit{
+		Network: "ethereum", TxHash: "feed", WalletID: 7, Asset: "ETH", Amount: amount.New(250000000000000000),
+	}
+
+	tests := []struct {
+		name             string
+		value            string
+		serviceError     error
+		expectService    bool
+		expectDelete     bool
+		expectDeadLetter string
+		expectUpdate     bool
+		expectedTotal    int
+	}{
+		{
+			name:          "when deposit is credited then should remove it",
+			value:         valid,
+			expectService: true,
+			expectDelete:  true,
+			expectedTotal: 1,
+		},
+		{
+			name:          "when deposit has been credited already then should remove it",
+			value:         valid,
+			serviceError:  ErrDepositAlreadyCredited,
+			expectService: true,
+			expectDelete:  true,
+		},
+		{
+			name:          "when wallet is still frozen then should retry it after a longer backoff",
+			value:         valid,
+			serviceError:  wallet.ErrWalletFrozen,
+			expectService: true,
+			expectUpdate:  true,
+		},
+		{
+			name:             "when deposit can never be credited then should dead-letter and remove it",
+			value:            valid,
+			serviceError:     ErrInvalidAsset,
+			expectService:    true,
+			expectDeadLetter: `{"type":"about:blank","title":"Bad Request","status":400,"code":"invalid_asset","detail":"invalid asset"}`,
+			expectDelete:     true,
+		},
+		{
+			name:          "when crediting fails transiently then should leave it to be retried",
+			value:         valid,
+			serviceError:  errors.New("connection refused"),
+			expectService: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := ledgermock.NewMockLedgerRepository(t)
+			mockService := ledgermock.NewMockLedgerService(t)
+			mockDeadLetters := ledgermock.NewMockDeadLetterWriter(t)
+			r, err := NewDepositRetrier(mockRepository, mockService, mockDeadLetters, config.LedgerConfig{
+				ParkedDepositInterval: time.Minute, ParkedDepositBatchSize: 2, ParkedDepositMaxBackoff: time.Hour,
+			})
+			assert.NoError(t, err)
+
+			deposit := &entity.ParkedDeposit{
+				ID: 5, SourceTopic: "chain-deposits", SourcePartition: 2, SourceOffset: 42,
+				Key: []byte("0xfeed"), Value: []byte(tt.value), Attempts: 2,
+			}
+			mockRepository.EXPECT().ClaimParkedDeposits(mock.Anything, now, time.Minute, 2).
+				Return([]*entity.ParkedDeposit{deposit}, nil).Once()
+
+			if tt.expectService {
+				var mockReturn *entity.ChainDeposit
+				if tt.serviceError == nil {
+					mockReturn = credited
+				}
+				mockService.EXPECT().CreditChainDeposit(mock.Anything, mock.AnythingOfType("*request.ChainDepositRequest")).
+					Return(mockReturn, tt.serviceError).Once()
+			}
+			if tt.expectDeadLetter != "" {
+				mockDeadLetters.EXPECT().WriteMessages(mock.Anything, mock.Anything).RunAndReturn(
+					func(_ context.Context, messages ...kafka.Message) error {
+						assert.Len(t, messages, 1)
+						assert.Equal(t, deposit.Value, messages[0].Value)
+						headers := make(map[string]string)
+						for _, header := range messages[0].Headers {
+							headers[header.Key] = string(header.Value)
+						}
+						assert.JSONEq(t, tt.expectDeadLetter, headers[HeaderDeadLetterError])
+						assert.Equal(t, "chain-deposits", headers[HeaderDeadLetterTopic])
+						assert.Equal(t, "42", headers[HeaderDeadLetterOffset])
+
+						return nil
+					}).Once()
+			}
+			if tt.expectDelete {
+				mockRepository.EXPECT().DeleteParkedDeposit(mock.Anything, uint(5)).Return(nil).Once()
+			}
+			if tt.expectUpdate {
+				mockRepository.EXPECT().UpdateParkedDeposit(mock.Anything, deposit).Return(nil).Once()
+			}
+
+			total, err := r.retry(context.Background(), now)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedTotal, total)
+			if tt.expectUpdate {
+				assert.Equal(t, 3, deposit.Attempts)
+				assert.Equal(t, now.Add(4*time.Minute), deposit.NextAttemptAt)
+				assert.Contains(t, deposit.Reason, "wallet_frozen")
+			}
+		})
+	}
+}
+
+func TestDepositRetrier_Backoff(t *testing.T) {
+	r, err := NewDepositRetrier(nil, nil, nil, config.LedgerConfig{
+		ParkedDepositInterval: time.Minute, ParkedDepositBatchSize: 1, ParkedDepositMaxBackoff: 10 * time.Minute,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, time.Minute, r.backoff(1))
+	assert.Equal(t, 2*time.Minute, r.backoff(2))
+	assert.Equal(t, 8*time.Minute, r.backoff(4))
+	assert.Equal(t, 10*time.Minute, r.backoff(5))
+	assert.Equal(t, 10*time.Minute, r.backoff(100))
+}
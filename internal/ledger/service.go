@@ -0,0 +1,576 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+	"github.com/safayildirim/wallet-management-service/internal/asset"
+	assetentity "github.com/safayildirim/wallet-management-service/internal/asset/entity"
+	"github.com/safayildirim/wallet-management-service/internal/common"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/entity"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/request"
+	"github.com/safayildirim/wallet-management-service/internal/network"
+	"github.com/safayildirim/wallet-management-service/internal/wallet"
+	walletrequest "github.com/safayildirim/wallet-management-service/internal/wallet/request"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type Service interface {
+	PostEntry(ctx context.Context, description string, lines []entity.PostingLine) (*entity.JournalEntry, error)
+	GetBalances(ctx context.Context, walletID uint) ([]entity.Balance, error)
+	Deposit(ctx context.Context, walletID uint, request *request.TransactionRequest) (*entity.Transaction, error)
+	Withdraw(ctx context.Context, walletID uint, request *request.TransactionRequest) (*entity.Transaction, error)
+	Transfer(ctx context.Context, request *request.TransferRequest) (*entity.Transfer, error)
+	CreditChainDeposit(ctx context.Context, request *request.ChainDepositRequest) (*entity.ChainDeposit, error)
+	ListTransactions(ctx context.Context, walletID uint, request *request.ListTransactionsRequest) ([]*entity.WalletTransaction, string, error)
+	ExportTransactions(ctx context.Context, walletID uint, request *request.ExportTransactionsRequest, fn func(*entity.WalletTransaction) error) error
+	CreateHold(ctx context.Context, walletID uint, request *request.HoldRequest) (*entity.Hold, error)
+	GetHold(ctx context.Context, walletID, holdID uint) (*entity.Hold, error)
+	CaptureHold(ctx context.Context, walletID, holdID uint) (*entity.Hold, error)
+	ReleaseHold(ctx context.Context, walletID, holdID uint) (*entity.Hold, error)
+}
+
+const defaultListLimit = request.DefaultListLimit
+
+var (
+	assetPattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9._-]{0,31}$`)
+	hexPattern   = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+)
+
+type service struct {
+	ledgerRepository Repository
+	walletService    wallet.Service
+	assetService     asset.Service
+	holdTTL          time.Duration
+	holdMaxTTL       time.Duration
+}
+
+func NewService(ledgerRepository Repository, walletService wallet.Service, assetService asset.Service, conf config.LedgerConfig) Service {
+	return &service{
+		ledgerRepository: ledgerRepository,
+		walletService:    walletService,
+		assetService:     assetService,
+		holdTTL:          conf.HoldTTL,
+		holdMaxTTL:       conf.HoldMaxTTL,
+	}
+}
+
+// PostEntry records a balanced journal entry.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - description: A human-readable description of the movement.
+//   - lines: The postings of the entry, addressed by account; assets are case-insensitive.
+//
+// Returns:
+//   - The recorded journal entry.
+//   - An error if a posting is invalid, the amounts of an asset do not add up to zero or
+//     recording fails.
+func (s *service) PostEntry(ctx context.Context, description string, lines []entity.PostingLine) (*entity.JournalEntry, error) {
+	if len(lines) < 2 {
+		return nil, ErrInvalidPosting.WithFields(map[string]string{"postings": "at least two postings are required"})
+	}
+
+	normalized := make([]entity.PostingLine, 0, len(lines))
+	sums := make(map[string]amount.Amount)
+	for i, line := range lines {
+		line.Account.Asset = NormalizeAsset(line.Account.Asset)
+		if err := validateLine(line); err != nil {
+			return nil, ErrInvalidPosting.WithFields(map[string]string{fmt.Sprintf("postings[%d]", i): err.Error()})
+		}
+
+		sums[line.Account.Asset] = sums[line.Account.Asset].Add(line.Amount)
+		normalized = append(normalized, line)
+	}
+
+	for asset, sum := range sums {
+		if !sum.IsZero() {
+			return nil, ErrUnbalancedEntry.WithFields(map[string]string{asset: fmt.Sprintf("postings add up to %s", sum)})
+		}
+	}
+
+	return s.ledgerRepository.PostEntry(ctx, description, normalized)
+}
+
+// GetBalances returns the balance of every asset a wallet has ever held.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - walletID: The unique identifier of the wallet.
+//
+// Returns:
+//   - The balances of the wallet ordered by asset, in integer base units.
+//   - An error if the wallet does not exist, belongs to another owner or retrieval fails.
+func (s *service) GetBalances(ctx context.Context, walletID uint) ([]entity.Balance, error) {
+	if _, err := s.walletService.GetWallet(ctx, walletID); err != nil {
+		return nil, err
+	}
+
+	return s.ledgerRepository.GetBalances(ctx, walletID)
+}
+
+// Deposit credits an amount of an asset to a wallet.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - walletID: The unique identifier of the wallet.
+//   - request: Request object containing the asset, the decimal amount and an optional reference.
+//
+// Returns:
+//   - The recorded deposit, including the resulting balance.
+//   - An error if the asset is not registered or disabled, the amount is invalid, the wallet does
+//     not exist, belongs to another owner or is not active or recording fails.
+func (s *service) Deposit(ctx context.Context, walletID uint, request *request.TransactionRequest) (*entity.Transaction, error) {
+	return s.recordTransaction(ctx, walletID, entity.TransactionTypeDeposit, request)
+}
+
+// Withdraw debits an amount of an asset from a wallet.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - walletID: The unique identifier of the wallet.
+//   - request: Request object containing the asset, the decimal amount and an optional reference.
+//
+// Returns:
+//   - The recorded withdrawal, including the resulting balance.
+//   - An error if the asset is not registered or disabled, the amount is invalid, the wallet does
+//     not exist, belongs to another owner or is not active, the balance is insufficient or
+//     recording fails.
+func (s *service) Withdraw(ctx context.Context, walletID uint, request *request.TransactionRequest) (*entity.Transaction, error) {
+	return s.recordTransaction(ctx, walletID, entity.TransactionTypeWithdrawal, request)
+}
+
+// Transfer moves an amount of an asset from one wallet to another in a single journal
+// entry, charging the sender the fee on top of the amount.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - request: Request object containing the wallets, the asset, the decimal amount and
+//     fee and an optional reference.
+//
+// Returns:
+//   - The recorded transfer, including its postings.
+//   - An error if the asset is not registered or disabled, the amount or fee is invalid, either
+//     wallet does not exist or is not active, the sender belongs to another owner, its balance is
+//     insufficient or recording fails.
+func (s *service) Transfer(ctx context.Context, request *request.TransferRequest) (*entity.Transfer, error) {
+	item, err := s.assetService.ResolveAsset(ctx, request.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	units, err := parsePositiveAmount("amount", request.Amount, item)
+	if err != nil {
+		return nil, err
+	}
+
+	var fee amount.Amount
+	if request.Fee != "" {
+		if fee, err = parseAmount("fee", request.Fee, item); err != nil {
+			return nil, err
+		}
+	}
+
+	// Only the sender has to be visible to the caller; the recipient may belong to anyone.
+	if _, err := s.walletService.GetWallet(ctx, request.FromWalletID); err != nil {
+		return nil, err
+	}
+
+	return s.ledgerRepository.RecordTransfer(ctx, &entity.Transfer{
+		FromWalletID: request.FromWalletID,
+		ToWalletID:   request.ToWalletID,
+		Asset:        item.Code,
+		Amount:       units,
+		Fee:          fee,
+		Reference:    request.Reference,
+	})
+}
+
+// CreditChainDeposit credits a deposit detected on chain to the wallet holding the receiving
+// address.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - request: Request object containing the network, the receiving address and memo, the asset,
+//     the decimal amount and the chain transaction hash and output index.
+//
+// Returns:
+//   - The credited deposit with the canonical transaction hash, address and memo, including the
+//     ID of the deposit transaction.
+//   - An error if the asset is not registered, disabled or not an asset of the network, the amount
+//     is invalid, the address or memo is not valid on the network, no wallet holds the address, the wallet is not active, the output has been
+//     credited already or recording fails.
+func (s *service) CreditChainDeposit(ctx context.Context, request *request.ChainDepositRequest) (*entity.ChainDeposit, error) {
+	item, err := s.assetService.ResolveAsset(ctx, request.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.Network != network.NormalizeCode(request.Network) {
+		return nil, ErrInvalidAsset.WithFields(map[string]string{
+			"asset": fmt.Sprintf("is not an asset of network %s", request.Network),
+		})
+	}
+
+	units, err := parsePositiveAmount("amount", request.Amount, item)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := s.walletService.CanonicalizeAddress(ctx, &walletrequest.GetWalletByAddressRequest{
+		Network: request.Network,
+		Address: request.Address,
+		Memo:    request.Memo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	holder, err := s.walletService.GetWalletByAddress(ctx, &walletrequest.GetWalletByAddressRequest{
+		Network: key.Network,
+		Address: key.Address,
+		Memo:    key.Memo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ledgerRepository.RecordChainDeposit(ctx, &entity.ChainDeposit{
+		Network:     item.Network,
+		TxHash:      canonicalTxHash(request.TxHash),
+		OutputIndex: uint(*request.OutputIndex),
+		Address:     key.Address,
+		Memo:        key.Memo,
+		WalletID:    holder.ID,
+		Asset:       item.Code,
+		Amount:      units,
+	})
+}
+
+// ListTransactions retrieves a page of the history of a wallet matching the filters.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - walletID: The unique identifier of the wallet.
+//   - request: Request object containing the filters, the ordering, the page size and the cursor.
+//
+// Returns:
+//   - The transactions of the page, newest first unless ordered otherwise.
+//   - The cursor of the next page, or an empty string on the last page.
+//   - An error if the asset or the cursor is invalid, the wallet does not exist, belongs to another
+//     owner or retrieval fails.
+func (s *service) ListTransactions(ctx context.Context, walletID uint, request *request.ListTransactionsRequest) ([]*entity.WalletTransaction, string, error) {
+	filter, err := s.transactionFilter(ctx, walletID, &request.TransactionFilterRequest)
+	if err != nil {
+		return nil, "", err
+	}
+
+	filter.Limit = request.Limit
+	if filter.Limit == 0 {
+		filter.Limit = defaultListLimit
+	}
+
+	if request.Cursor != "" {
+		var cursor transactionCursor
+		if err := common.DecodeCursor(request.Cursor, &cursor); err != nil {
+			return nil, "", ErrInvalidCursor
+		}
+		// A cursor is only meaningful for the ordering it was issued for.
+		if cursor.Descending != filter.Descending || cursor.EntryID == 0 {
+			return nil, "", ErrInvalidCursor
+		}
+		filter.AfterEntryID = cursor.EntryID
+	}
+
+	// Fetch one extra row to find out whether there is a next page.
+	limit := filter.Limit
+	filter.Limit++
+
+	items, err := s.ledgerRepository.ListWalletTransactions(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(items) <= limit {
+		return items, "", nil
+	}
+
+	items = items[:limit]
+	next, err := common.EncodeCursor(transactionCursor{
+		Descending: filter.Descending,
+		EntryID:    items[len(items)-1].EntryID,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return items, next, nil
+}
+
+// ExportTransactions streams the whole history of a wallet matching the filters.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - walletID: The unique identifier of the wallet.
+//   - request: Request object containing the filters and the ordering.
+//   - fn: Called for every transaction in order; streaming stops at the first error it returns.
+//
+// Returns:
+//   - An error if the asset is invalid, the wallet does not exist, belongs to another owner,
+//     retrieval fails or fn returns an error.
+func (s *service) ExportTransactions(ctx context.Context, walletID uint, request *request.ExportTransactionsRequest, fn func(*entity.WalletTransaction) error) error {
+	filter, err := s.transactionFilter(ctx, walletID, &request.TransactionFilterRequest)
+	if err != nil {
+		return err
+	}
+
+	return s.ledgerRepository.StreamWalletTransactions(ctx, filter, fn)
+}
+
+// transactionFilter builds the history filter of a wallet the caller may see.
+func (s *service) transactionFilter(ctx context.Context, walletID uint, request *request.TransactionFilterRequest) (entity.TransactionFilter, error) {
+	filter := entity.TransactionFilter{
+		WalletID:    walletID,
+		Types:       request.Type,
+		Status:      request.Status,
+		CreatedFrom: request.CreatedFrom,
+		CreatedTo:   request.CreatedTo,
+		// History is read newest first unless asked otherwise.
+		Descending: request.Order != "asc",
+	}
+
+	if request.Asset != "" {
+		filter.Asset = NormalizeAsset(request.Asset)
+		if !assetPattern.MatchString(filter.Asset) {
+			return filter, ErrInvalidAsset.WithFields(map[string]string{"asset": "must be in a valid format"})
+		}
+	}
+
+	if _, err := s.walletService.GetWallet(ctx, walletID); err != nil {
+		return filter, err
+	}
+
+	return filter, nil
+}
+
+// CreateHold reserves an amount of an asset of a wallet by moving it from the available to the
+// held balance.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - walletID: The unique identifier of the wallet.
+//   - request: Request object containing the asset, the decimal amount, an optional expiry and
+//     an optional reference.
+//
+// Returns:
+//   - The created hold.
+//   - An error if the asset is not registered or disabled, the amount or the expiry is invalid, the
+//     wallet does not exist, belongs to another owner or is not active, the available balance is
+//     insufficient or recording fails.
+func (s *service) CreateHold(ctx context.Context, walletID uint, request *request.HoldRequest) (*entity.Hold, error) {
+	item, err := s.assetService.ResolveAsset(ctx, request.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	units, err := parsePositiveAmount("amount", request.Amount, item)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.holdTTL)
+	if request.ExpiresAt != nil {
+		expiresAt = *request.ExpiresAt
+		if !expiresAt.After(now) {
+			return nil, ErrInvalidExpiry.WithFields(map[string]string{"expires_at": "must be in the future"})
+		}
+		if expiresAt.After(now.Add(s.holdMaxTTL)) {
+			return nil, ErrInvalidExpiry.WithFields(map[string]string{
+				"expires_at": fmt.Sprintf("must be within %s", s.holdMaxTTL),
+			})
+		}
+	}
+
+	if _, err := s.walletService.GetWallet(ctx, walletID); err != nil {
+		return nil, err
+	}
+
+	return s.ledgerRepository.CreateHold(ctx, &entity.Hold{
+		WalletID:  walletID,
+		Asset:     item.Code,
+		Amount:    units,
+		ExpiresAt: expiresAt,
+		Reference: request.Reference,
+	})
+}
+
+// GetHold retrieves a hold of a wallet.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - walletID: The unique identifier of the wallet.
+//   - holdID: The unique identifier of the hold.
+//
+// Returns:
+//   - The hold.
+//   - An error if the wallet or the hold does not exist, the wallet belongs to another owner or
+//     retrieval fails.
+func (s *service) GetHold(ctx context.Context, walletID, holdID uint) (*entity.Hold, error) {
+	if _, err := s.walletService.GetWallet(ctx, walletID); err != nil {
+		return nil, err
+	}
+
+	return s.ledgerRepository.GetHold(ctx, walletID, holdID)
+}
+
+// CaptureHold debits the amount of a hold from the wallet, recording it as a withdrawal.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - walletID: The unique identifier of the wallet.
+//   - holdID: The unique identifier of the hold.
+//
+// Returns:
+//   - The captured hold, including the ID of the withdrawal.
+//   - An error if the wallet or the hold does not exist, the wallet belongs to another owner or is
+//     not active, the hold has been settled already or has expired, or recording fails.
+func (s *service) CaptureHold(ctx context.Context, walletID, holdID uint) (*entity.Hold, error) {
+	if _, err := s.walletService.GetWallet(ctx, walletID); err != nil {
+		return nil, err
+	}
+
+	return s.ledgerRepository.CaptureHold(ctx, walletID, holdID, time.Now())
+}
+
+// ReleaseHold makes the amount of a hold available again.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - walletID: The unique identifier of the wallet.
+//   - holdID: The unique identifier of the hold.
+//
+// Returns:
+//   - The released hold.
+//   - An error if the wallet or the hold does not exist, the wallet belongs to another owner, the
+//     hold has been settled already or recording fails.
+func (s *service) ReleaseHold(ctx context.Context, walletID, holdID uint) (*entity.Hold, error) {
+	if _, err := s.walletService.GetWallet(ctx, walletID); err != nil {
+		return nil, err
+	}
+
+	return s.ledgerRepository.ReleaseHold(ctx, walletID, holdID, time.Now())
+}
+
+// recordTransaction records a deposit or withdrawal on a wallet the caller may see.
+func (s *service) recordTransaction(ctx context.Context, walletID uint, transactionType string, request *request.TransactionRequest) (*entity.Transaction, error) {
+	item, err := s.assetService.ResolveAsset(ctx, request.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	units, err := parsePositiveAmount("amount", request.Amount, item)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.walletService.GetWallet(ctx, walletID); err != nil {
+		return nil, err
+	}
+
+	return s.ledgerRepository.RecordTransaction(ctx, &entity.Transaction{
+		WalletID:  walletID,
+		Type:      transactionType,
+		Asset:     item.Code,
+		Amount:    units,
+		Reference: request.Reference,
+	})
+}
+
+// canonicalTxHash returns the form a chain transaction hash is stored and deduplicated in. Hex
+// hashes are case-insensitive and written with or without a 0x prefix, so they are lowercased
+// without it; other hashes, such as base58 ones, are case-sensitive and only trimmed.
+func canonicalTxHash(hash string) string {
+	hash = strings.TrimSpace(hash)
+	digits := hash
+	if len(digits) > 2 && (digits[:2] == "0x" || digits[:2] == "0X") {
+		digits = digits[2:]
+	}
+	if !hexPattern.MatchString(digits) {
+		return hash
+	}
+
+	return strings.ToLower(digits)
+}
+
+// NormalizeAsset returns the canonical, uppercase form of an asset code.
+func NormalizeAsset(asset string) string {
+	return strings.ToUpper(strings.TrimSpace(asset))
+}
+
+// validateLine checks a single posting whose asset is normalized already.
+func validateLine(line entity.PostingLine) error {
+	if !assetPattern.MatchString(line.Account.Asset) {
+		return ErrInvalidAsset
+	}
+
+	switch line.Account.Kind {
+	case entity.AccountKindWallet, entity.AccountKindHold:
+		if line.Account.WalletID == 0 {
+			return errors.Errorf("%s account requires a wallet", line.Account.Kind)
+		}
+	case entity.AccountKindExternal, entity.AccountKindFee:
+		if line.Account.WalletID != 0 {
+			return errors.Errorf("%s account cannot belong to a wallet", line.Account.Kind)
+		}
+	default:
+		return errors.Errorf("unknown account kind %q", line.Account.Kind)
+	}
+
+	if line.Amount.IsZero() {
+		return errors.New("amount cannot be zero")
+	}
+
+	return nil
+}
+
+// parseAmount converts the decimal amount of a request field into base units of the asset;
+// digits beyond the decimals of the asset are rejected rather than rounded, and so are
+// negative amounts.
+func parseAmount(field string, value amount.Decimal, item *assetentity.Asset) (amount.Amount, error) {
+	units, err := value.Units(item.Decimals, amount.RoundExact)
+	if err != nil {
+		return amount.Amount{}, ErrInvalidAmount.WithFields(map[string]string{field: err.Error()})
+	}
+
+	if units.Sign() < 0 {
+		return amount.Amount{}, ErrInvalidAmount.WithFields(map[string]string{field: "must not be negative"})
+	}
+
+	return units, nil
+}
+
+// parsePositiveAmount converts the decimal amount of a request field into base units of the
+// asset, rejecting amounts that are not positive.
+func parsePositiveAmount(field string, value amount.Decimal, item *assetentity.Asset) (amount.Amount, error) {
+	units, err := parseAmount(field, value, item)
+	if err != nil {
+		return amount.Amount{}, err
+	}
+
+	if units.IsZero() {
+		return amount.Amount{}, ErrInvalidAmount.WithFields(map[string]string{field: "must be greater than zero"})
+	}
+
+	return units, nil
+}
+
+// transactionCursor is the decoded form of the opaque cursor returned by ListTransactions.
+type transactionCursor struct {
+	Descending bool `json:"d"`
+	EntryID    uint `json:"e"`
+}
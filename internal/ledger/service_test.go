@@ -0,0 +1,846 @@
+package ledger
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/address"
+	"github.com/safayildirim/wallet-management-service/internal/amount"
+	"github.com/safayildirim/wallet-management-service/internal/asset"
+	assetentity "github.com/safayildirim/wallet-management-service/internal/asset/entity"
+	assetmock "github.com/safayildirim/wallet-management-service/internal/asset/mock"
+	"github.com/safayildirim/wallet-management-service/internal/common"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/entity"
+	ledgermock "github.com/safayildirim/wallet-management-service/internal/ledger/mock"
+	"github.com/safayildirim/wallet-management-service/internal/ledger/request"
+	"github.com/safayildirim/wallet-management-service/internal/wallet"
+	walletentity "github.com/safayildirim/wallet-management-service/internal/wallet/entity"
+	walletmock "github.com/safayildirim/wallet-management-service/internal/wallet/mock"
+	walletrequest "github.com/safayildirim/wallet-management-service/internal/wallet/request"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestService_PostEntry(t *testing.T) {
+	tests := []struct {
+		name           string
+		lines          []entity.PostingLine
+		mockRepository bool
+		expectedLines  []entity.PostingLine
+		mockError      error
+		expectedError  error
+	}{
+		{
+			name: "when entry balances then should record entry with normalized assets",
+			lines: []entity.PostingLine{
+				{Account: entity.ExternalAccount("btc"), Amount: amount.New(-150000)},
+				{Account: entity.WalletAccount(1, "BTC"), Amount: amount.New(100000)},
+				{Account: entity.WalletAccount(2, " btc "), Amount: amount.New(50000)},
+			},
+			mockRepository: true,
+			expectedLines: []entity.PostingLine{
+				{Account: entity.ExternalAccount("BTC"), Amount: amount.New(-150000)},
+				{Account: entity.WalletAccount(1, "BTC"), Amount: amount.New(100000)},
+				{Account: entity.WalletAccount(2, "BTC"), Amount: amount.New(50000)},
+			},
+		},
+		{
+			name: "when entry moves several assets then should balance each asset",
+			lines: []entity.PostingLine{
+				{Account: entity.WalletAccount(1, "BTC"), Amount: amount.New(-1)},
+				{Account: entity.WalletAccount(2, "BTC"), Amount: amount.New(1)},
+				{Account: entity.WalletAccount(2, "ETH"), Amount: amount.New(-7)},
+				{Account: entity.WalletAccount(1, "ETH"), Amount: amount.New(7)},
+			},
+			mockRepository: true,
+			expectedLines: []entity.PostingLine{
+				{Account: entity.WalletAccount(1, "BTC"), Amount: amount.New(-1)},
+				{Account: entity.WalletAccount(2, "BTC"), Amount: amount.New(1)},
+				{Account: entity.WalletAccount(2, "ETH"), Amount: amount.New(-7)},
+				{Account: entity.WalletAccount(1, "ETH"), Amount: amount.New(7)},
+			},
+		},
+		{
+			name: "when entry does not balance then should return error",
+			lines: []entity.PostingLine{
+				{Account: entity.ExternalAccount("BTC"), Amount: amount.New(-100)},
+				{Account: entity.WalletAccount(1, "BTC"), Amount: amount.New(99)},
+			},
+			expectedError: ErrUnbalancedEntry,
+		},
+		{
+			name: "when assets cancel out across assets only then should return error",
+			lines: []entity.PostingLine{
+				{Account: entity.WalletAccount(1, "BTC"), Amount: amount.New(-5)},
+				{Account: entity.WalletAccount(2, "ETH"), Amount: amount.New(5)},
+			},
+			expectedError: ErrUnbalancedEntry,
+		},
+		{
+			name: "when amounts exceed an int64 then should record entry",
+			lines: []entity.PostingLine{
+				{Account: entity.ExternalAccount("ETH"), Amount: amount.MustParse("-25000000000000000000")},
+				{Account: entity.WalletAccount(1, "ETH"), Amount: amount.MustParse("25000000000000000000")},
+			},
+			mockRepository: true,
+			expectedLines: []entity.PostingLine{
+				{Account: entity.ExternalAccount("ETH"), Amount: amount.MustParse("-25000000000000000000")},
+				{Account: entity.WalletAccount(1, "ETH"), Amount: amount.MustParse("25000000000000000000")},
+			},
+		},
+		{
+			name:          "when entry has a single posting then should return error",
+			lines:         []entity.PostingLine{{Account: entity.WalletAccount(1, "BTC"), Amount: amount.New(0)}},
+			expectedError: ErrInvalidPosting,
+		},
+		{
+			name: "when amount is zero then should return error",
+			lines: []entity.PostingLine{
+				{Account: entity.ExternalAccount("BTC"), Amount: amount.New(0)},
+				{Account: entity.WalletAccount(1, "BTC"), Amount: amount.New(0)},
+			},
+			expectedError: ErrInvalidPosting,
+		},
+		{
+			name: "when asset is invalid then should return error",
+			lines: []entity.PostingLine{
+				{Account: entity.ExternalAccount("bit coin"), Amount: amount.New(-1)},
+				{Account: entity.WalletAccount(1, "bit coin"), Amount: amount.New(1)},
+			},
+			expectedError: ErrInvalidPosting,
+		},
+		{
+			name: "when wallet account has no wallet then should return error",
+			lines: []entity.PostingLine{
+				{Account: entity.ExternalAccount("BTC"), Amount: amount.New(-1)},
+				{Account: entity.WalletAccount(0, "BTC"), Amount: amount.New(1)},
+			},
+			expectedError: ErrInvalidPosting,
+		},
+		{
+			name: "when repository returns an error then should return error",
+			lines: []entity.PostingLine{
+				{Account: entity.ExternalAccount("BTC"), Amount: amount.New(-1)},
+				{Account: entity.WalletAccount(1, "BTC"), Amount: amount.New(1)},
+			},
+			mockRepository: true,
+			expectedLines: []entity.PostingLine{
+				{Account: entity.ExternalAccount("BTC"), Amount: amount.New(-1)},
+				{Account: entity.WalletAccount(1, "BTC"), Amount: amount.New(1)},
+			},
+			mockError:     errors.New("repository error"),
+			expectedError: errors.New("repository error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := ledgermock.NewMockLedgerRepository(t)
+			s := NewService(mockRepository, walletmock.NewMockWalletService(t), assetmock.NewMockAssetService(t), config.LedgerConfig{})
+
+			if tt.mockRepository {
+				var mockReturn *entity.JournalEntry
+				if tt.mockError == nil {
+					mockReturn = &entity.JournalEntry{ID: 1, Description: "deposit"}
+				}
+				mockRepository.EXPECT().PostEntry(mock.Anything, "deposit", tt.expectedLines).Return(mockReturn, tt.mockError).Once()
+			}
+
+			result, err := s.PostEntry(context.Background(), "deposit", tt.lines)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(1), result.ID)
+			}
+		})
+	}
+}
+
+func TestService_GetBalances(t *testing.T) {
+	tests := []struct {
+		name           string
+		walletErr      error
+		mockRepository bool
+		mockReturn     []entity.Balance
+		expectedResult []entity.Balance
+		expectedError  error
+	}{
+		{
+			name:           "when wallet exists then should return balances",
+			mockRepository: true,
+			mockReturn:     []entity.Balance{{Asset: "BTC", Available: amount.New(150000), Total: amount.New(150000)}, {Asset: "ETH"}},
+			expectedResult: []entity.Balance{{Asset: "BTC", Available: amount.New(150000), Total: amount.New(150000)}, {Asset: "ETH"}},
+		},
+		{
+			name:          "when wallet does not exist then should return error",
+			walletErr:     wallet.ErrWalletNotFound,
+			expectedError: wallet.ErrWalletNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := ledgermock.NewMockLedgerRepository(t)
+			mockWalletService := walletmock.NewMockWalletService(t)
+			s := NewService(mockRepository, mockWalletService, assetmock.NewMockAssetService(t), config.LedgerConfig{})
+
+			var mockWallet *walletentity.Wallet
+			if tt.walletErr == nil {
+				mockWallet = &walletentity.Wallet{ID: 1}
+			}
+			mockWalletService.EXPECT().GetWallet(mock.Anything, uint(1)).Return(mockWallet, tt.walletErr).Once()
+			if tt.mockRepository {
+				mockRepository.EXPECT().GetBalances(mock.Anything, uint(1)).Return(tt.mockReturn, nil).Once()
+			}
+
+			result, err := s.GetBalances(context.Background(), 1)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestService_RecordTransaction(t *testing.T) {
+	tests := []struct {
+		name                string
+		withdraw            bool
+		request             *request.TransactionRequest
+		walletErr           error
+		mockRepository      bool
+		expectedTransaction *entity.Transaction
+		mockError           error
+		expectedError       error
+	}{
+		{
+			name:                "when deposit is valid then should record deposit",
+			request:             &request.TransactionRequest{Asset: "btc", Amount: "0.001", Reference: "tx-1"},
+			mockRepository:      true,
+			expectedTransaction: &entity.Transaction{WalletID: 1, Type: entity.TransactionTypeDeposit, Asset: "BTC", Amount: amount.New(100000), Reference: "tx-1"},
+		},
+		{
+			name:                "when withdrawal is valid then should record withdrawal",
+			withdraw:            true,
+			request:             &request.TransactionRequest{Asset: "ETH", Amount: "0.5"},
+			mockRepository:      true,
+			expectedTransaction: &entity.Transaction{WalletID: 1, Type: entity.TransactionTypeWithdrawal, Asset: "ETH", Amount: amount.New(500000000000000000)},
+		},
+		{
+			name:                "when balance is insufficient then should return error",
+			withdraw:            true,
+			request:             &request.TransactionRequest{Asset: "ETH", Amount: "0.5"},
+			mockRepository:      true,
+			expectedTransaction: &entity.Transaction{WalletID: 1, Type: entity.TransactionTypeWithdrawal, Asset: "ETH", Amount: amount.New(500000000000000000)},
+			mockError:           ErrInsufficientFunds,
+			expectedError:       ErrInsufficientFunds,
+		},
+		{
+			name:                "when wallet is frozen then should return error",
+			request:             &request.TransactionRequest{Asset: "ETH", Amount: "0.5"},
+			mockRepository:      true,
+			expectedTransaction: &entity.Transaction{WalletID: 1, Type: entity.TransactionTypeDeposit, Asset: "ETH", Amount: amount.New(500000000000000000)},
+			mockError:           wallet.ErrWalletFrozen,
+			expectedError:       wallet.ErrWalletFrozen,
+		},
+		{
+			name:          "when wallet does not exist then should return error",
+			request:       &request.TransactionRequest{Asset: "ETH", Amount: "0.5"},
+			walletErr:     wallet.ErrWalletNotFound,
+			expectedError: wallet.ErrWalletNotFound,
+		},
+		{
+			name:          "when asset is not registered then should return error",
+			request:       &request.TransactionRequest{Asset: "DOGE", Amount: "5"},
+			expectedError: asset.ErrUnknownAsset,
+		},
+		{
+			name:          "when amount has more decimals than asset then should return error",
+			request:       &request.TransactionRequest{Asset: "BTC", Amount: "0.000000001"},
+			expectedError: ErrInvalidAmount,
+		},
+		{
+			name:          "when amount is zero then should return error",
+			request:       &request.TransactionRequest{Asset: "BTC", Amount: "0.00"},
+			expectedError: ErrInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := ledgermock.NewMockLedgerRepository(t)
+			mockWalletService := walletmock.NewMockWalletService(t)
+			s := NewService(mockRepository, mockWalletService, mockAssets(t), config.LedgerConfig{})
+
+			if tt.walletErr != nil || tt.mockRepository {
+				var mockWallet *walletentity.Wallet
+				if tt.walletErr == nil {
+					mockWallet = &walletentity.Wallet{ID: 1}
+				}
+				mockWalletService.EXPECT().GetWallet(mock.Anything, uint(1)).Return(mockWallet, tt.walletErr).Once()
+			}
+			if tt.mockRepository {
+				var mockReturn *entity.Transaction
+				if tt.mockError == nil {
+					mockReturn = tt.expectedTransaction
+				}
+				mockRepository.EXPECT().RecordTransaction(mock.Anything, tt.expectedTransaction).Return(mockReturn, tt.mockError).Once()
+			}
+
+			var result *entity.Transaction
+			var err error
+			if tt.withdraw {
+				result, err = s.Withdraw(context.Background(), 1, tt.request)
+			} else {
+				result, err = s.Deposit(context.Background(), 1, tt.request)
+			}
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedTransaction, result)
+			}
+		})
+	}
+}
+
+func TestService_Transfer(t *testing.T) {
+	tests := []struct {
+		name             string
+		request          *request.TransferRequest
+		walletErr        error
+		mockRepository   bool
+		expectedTransfer *entity.Transfer
+		mockError        error
+		expectedError    error
+	}{
+		{
+			name:             "when transfer is valid then should record transfer",
+			request:          &request.TransferRequest{FromWalletID: 1, ToWalletID: 2, Asset: "btc", Amount: "0.00001", Fee: "0.0000001", Reference: "t-1"},
+			mockRepository:   true,
+			expectedTransfer: &entity.Transfer{FromWalletID: 1, ToWalletID: 2, Asset: "BTC", Amount: amount.New(1000), Fee: amount.New(10), Reference: "t-1"},
+		},
+		{
+			name:             "when balance is insufficient then should return error",
+			request:          &request.TransferRequest{FromWalletID: 1, ToWalletID: 2, Asset: "BTC", Amount: "0.00001"},
+			mockRepository:   true,
+			expectedTransfer: &entity.Transfer{FromWalletID: 1, ToWalletID: 2, Asset: "BTC", Amount: amount.New(1000)},
+			mockError:        ErrInsufficientFunds,
+			expectedError:    ErrInsufficientFunds,
+		},
+		{
+			name:             "when recipient is frozen then should return error",
+			request:          &request.TransferRequest{FromWalletID: 1, ToWalletID: 2, Asset: "BTC", Amount: "0.00001"},
+			mockRepository:   true,
+			expectedTransfer: &entity.Transfer{FromWalletID: 1, ToWalletID: 2, Asset: "BTC", Amount: amount.New(1000)},
+			mockError:        wallet.ErrWalletFrozen,
+			expectedError:    wallet.ErrWalletFrozen,
+		},
+		{
+			name:          "when sender is not visible then should return error",
+			request:       &request.TransferRequest{FromWalletID: 1, ToWalletID: 2, Asset: "BTC", Amount: "0.00001"},
+			walletErr:     wallet.ErrWalletNotFound,
+			expectedError: wallet.ErrWalletNotFound,
+		},
+		{
+			name:           "when amount exceeds an int64 then should record transfer",
+			request:        &request.TransferRequest{FromWalletID: 1, ToWalletID: 2, Asset: "ETH", Amount: "25", Fee: "0.001"},
+			mockRepository: true,
+			expectedTransfer: &entity.Transfer{
+				FromWalletID: 1, ToWalletID: 2, Asset: "ETH",
+				Amount: amount.MustParse("25000000000000000000"), Fee: amount.New(1000000000000000),
+			},
+		},
+		{
+			name:          "when fee is negative then should return error",
+			request:       &request.TransferRequest{FromWalletID: 1, ToWalletID: 2, Asset: "BTC", Amount: "1", Fee: "-1"},
+			expectedError: ErrInvalidAmount,
+		},
+		{
+			name:          "when asset is disabled then should return error",
+			request:       &request.TransferRequest{FromWalletID: 1, ToWalletID: 2, Asset: "XRP", Amount: "1"},
+			expectedError: asset.ErrAssetDisabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := ledgermock.NewMockLedgerRepository(t)
+			mockWalletService := walletmock.NewMockWalletService(t)
+			s := NewService(mockRepository, mockWalletService, mockAssets(t), config.LedgerConfig{})
+
+			if tt.walletErr != nil || tt.mockRepository {
+				var mockWallet *walletentity.Wallet
+				if tt.walletErr == nil {
+					mockWallet = &walletentity.Wallet{ID: 1}
+				}
+				mockWalletService.EXPECT().GetWallet(mock.Anything, uint(1)).Return(mockWallet, tt.walletErr).Once()
+			}
+			if tt.mockRepository {
+				var mockReturn *entity.Transfer
+				if tt.mockError == nil {
+					mockReturn = tt.expectedTransfer
+				}
+				mockRepository.EXPECT().RecordTransfer(mock.Anything, tt.expectedTransfer).Return(mockReturn, tt.mockError).Once()
+			}
+
+			result, err := s.Transfer(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedTransfer, result)
+			}
+		})
+	}
+}
+
+func TestService_CreditChainDeposit(t *testing.T) {
+	outputIndex := func(i int) *int { return &i }
+	deposit := &request.ChainDepositRequest{
+		Network: "Ethereum", Address: "0xabc", Asset: "eth", Amount: "0.25", TxHash: " 0xFeEd ", OutputIndex: outputIndex(3),
+	}
+	credited := &entity.ChainDeposit{
+		Network: "ethereum", TxHash: "feed", OutputIndex: 3, Address: "0xABC",
+		WalletID: 7, Asset: "ETH", Amount: amount.New(250000000000000000),
+	}
+
+	tests := []struct {
+		name            string
+		request         *request.ChainDepositRequest
+		canonicalizeErr error
+		walletErr       error
+		mockRepository  bool
+		expectedDeposit *entity.ChainDeposit
+		mockError       error
+		expectedError   error
+	}{
+		{
+			name:            "when deposit is valid then should credit wallet holding address",
+			request:         deposit,
+			mockRepository:  true,
+			expectedDeposit: credited,
+		},
+		{
+			name: "when hash is not hex then should keep its case",
+			request: &request.ChainDepositRequest{
+				Network: "Ethereum", Address: "0xabc", Asset: "ETH", Amount: "1", TxHash: "5VERv8NMvzbJMEkV", OutputIndex: outputIndex(0),
+			},
+			mockRepository: true,
+			expectedDeposit: &entity.ChainDeposit{
+				Network: "ethereum", TxHash: "5VERv8NMvzbJMEkV", Address: "0xABC",
+				WalletID: 7, Asset: "ETH", Amount: amount.New(1000000000000000000),
+			},
+		},
+		{
+			name:            "when address is not valid on network then should return error",
+			request:         deposit,
+			canonicalizeErr: address.ErrInvalidAddress,
+			expectedError:   address.ErrInvalidAddress,
+		},
+		{
+			name:            "when output has been credited already then should return error",
+			request:         deposit,
+			mockRepository:  true,
+			expectedDeposit: credited,
+			mockError:       ErrDepositAlreadyCredited,
+			expectedError:   ErrDepositAlreadyCredited,
+		},
+		{
+			name:          "when no wallet holds address then should return error",
+			request:       deposit,
+			walletErr:     wallet.ErrWalletNotFound,
+			expectedError: wallet.ErrWalletNotFound,
+		},
+		{
+			name: "when asset belongs to another network then should return error",
+			request: &request.ChainDepositRequest{
+				Network: "Ethereum", Address: "0xabc", Asset: "BTC", Amount: "1", TxHash: "0xfeed", OutputIndex: outputIndex(0),
+			},
+			expectedError: ErrInvalidAsset,
+		},
+		{
+			name: "when asset is not registered then should return error",
+			request: &request.ChainDepositRequest{
+				Network: "Ethereum", Address: "0xabc", Asset: "DOGE", Amount: "1", TxHash: "0xfeed", OutputIndex: outputIndex(0),
+			},
+			expectedError: asset.ErrUnknownAsset,
+		},
+		{
+			name: "when amount is zero then should return error",
+			request: &request.ChainDepositRequest{
+				Network: "Ethereum", Address: "0xabc", Asset: "ETH", Amount: "0", TxHash: "0xfeed", OutputIndex: outputIndex(0),
+			},
+			expectedError: ErrInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := ledgermock.NewMockLedgerRepository(t)
+			mockWalletService := walletmock.NewMockWalletService(t)
+			s := NewService(mockRepository, mockWalletService, mockAssets(t), config.LedgerConfig{})
+
+			if tt.canonicalizeErr != nil || tt.walletErr != nil || tt.mockRepository {
+				var key walletentity.WalletKey
+				if tt.canonicalizeErr == nil {
+					key = walletentity.WalletKey{Network: "ethereum", Address: "0xABC"}
+				}
+				lookup := &walletrequest.GetWalletByAddressRequest{Network: "Ethereum", Address: "0xabc"}
+				mockWalletService.EXPECT().CanonicalizeAddress(mock.Anything, lookup).Return(key, tt.canonicalizeErr).Once()
+			}
+			if tt.walletErr != nil || tt.mockRepository {
+				var mockWallet *walletentity.Wallet
+				if tt.walletErr == nil {
+					mockWallet = &walletentity.Wallet{ID: 7}
+				}
+				lookup := &walletrequest.GetWalletByAddressRequest{Network: "ethereum", Address: "0xABC"}
+				mockWalletService.EXPECT().GetWalletByAddress(mock.Anything, lookup).Return(mockWallet, tt.walletErr).Once()
+			}
+			if tt.mockRepository {
+				var mockReturn *entity.ChainDeposit
+				if tt.mockError == nil {
+					mockReturn = tt.expectedDeposit
+				}
+				mockRepository.EXPECT().RecordChainDeposit(mock.Anything, tt.expectedDeposit).Return(mockReturn, tt.mockError).Once()
+			}
+
+			result, err := s.CreditChainDeposit(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedDeposit, result)
+			}
+		})
+	}
+}
+
+func TestService_ListTransactions(t *testing.T) {
+	nextCursor, _ := common.EncodeCursor(transactionCursor{Descending: true, EntryID: 8})
+	ascCursor, _ := common.EncodeCursor(transactionCursor{Descending: false, EntryID: 8})
+
+	tests := []struct {
+		name           string
+		request        *request.ListTransactionsRequest
+		walletErr      error
+		mockRepository bool
+		expectedFilter entity.TransactionFilter
+		mockReturn     []*entity.WalletTransaction
+		expectedResult []*entity.WalletTransaction
+		expectedNext   string
+		expectedError  error
+	}{
+		{
+			name:           "when no filters are given then should list newest first with default limit",
+			request:        &request.ListTransactionsRequest{},
+			mockRepository: true,
+			expectedFilter: entity.TransactionFilter{WalletID: 1, Descending: true, Limit: defaultListLimit + 1},
+			mockReturn:     []*entity.WalletTransaction{{EntryID: 9}},
+			expectedResult: []*entity.WalletTransaction{{EntryID: 9}},
+		},
+		{
+			name: "when there are more transactions then should return cursor of next page",
+			request: &request.ListTransactionsRequest{
+				TransactionFilterRequest: request.TransactionFilterRequest{Asset: "btc", Type: []string{"deposit"}},
+				Limit:                    2,
+			},
+			mockRepository: true,
+			expectedFilter: entity.TransactionFilter{WalletID: 1, Asset: "BTC", Types: []string{"deposit"}, Descending: true, Limit: 3},
+			mockReturn:     []*entity.WalletTransaction{{EntryID: 10}, {EntryID: 8}, {EntryID: 5}},
+			expectedResult: []*entity.WalletTransaction{{EntryID: 10}, {EntryID: 8}},
+			expectedNext:   nextCursor,
+		},
+		{
+			name:           "when cursor is given then should continue after it",
+			request:        &request.ListTransactionsRequest{Cursor: nextCursor, Limit: 2},
+			mockRepository: true,
+			expectedFilter: entity.TransactionFilter{WalletID: 1, Descending: true, Limit: 3, AfterEntryID: 8},
+			mockReturn:     []*entity.WalletTransaction{{EntryID: 5}},
+			expectedResult: []*entity.WalletTransaction{{EntryID: 5}},
+		},
+		{
+			name: "when cursor was issued for another order then should return error",
+			request: &request.ListTransactionsRequest{
+				TransactionFilterRequest: request.TransactionFilterRequest{Order: "desc"},
+				Cursor:                   ascCursor,
+			},
+			expectedError: ErrInvalidCursor,
+		},
+		{
+			name:          "when cursor is malformed then should return error",
+			request:       &request.ListTransactionsRequest{Cursor: "not-a-cursor"},
+			expectedError: ErrInvalidCursor,
+		},
+		{
+			name:          "when wallet does not exist then should return error",
+			request:       &request.ListTransactionsRequest{},
+			walletErr:     wallet.ErrWalletNotFound,
+			expectedError: wallet.ErrWalletNotFound,
+		},
+		{
+			name: "when asset is invalid then should return error",
+			request: &request.ListTransactionsRequest{
+				TransactionFilterRequest: request.TransactionFilterRequest{Asset: "bit coin"},
+			},
+			expectedError: ErrInvalidAsset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := ledgermock.NewMockLedgerRepository(t)
+			mockWalletService := walletmock.NewMockWalletService(t)
+			s := NewService(mockRepository, mockWalletService, assetmock.NewMockAssetService(t), config.LedgerConfig{})
+
+			if tt.expectedError != ErrInvalidAsset {
+				var mockWallet *walletentity.Wallet
+				if tt.walletErr == nil {
+					mockWallet = &walletentity.Wallet{ID: 1}
+				}
+				mockWalletService.EXPECT().GetWallet(mock.Anything, uint(1)).Return(mockWallet, tt.walletErr).Once()
+			}
+			if tt.mockRepository {
+				mockRepository.EXPECT().ListWalletTransactions(mock.Anything, tt.expectedFilter).Return(tt.mockReturn, nil).Once()
+			}
+
+			result, next, err := s.ListTransactions(context.Background(), 1, tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+				assert.Equal(t, tt.expectedNext, next)
+			}
+		})
+	}
+}
+
+func TestService_ExportTransactions(t *testing.T) {
+	mockRepository := ledgermock.NewMockLedgerRepository(t)
+	mockWalletService := walletmock.NewMockWalletService(t)
+	s := NewService(mockRepository, mockWalletService, assetmock.NewMockAssetService(t), config.LedgerConfig{})
+
+	expectedFilter := entity.TransactionFilter{WalletID: 1, Types: []string{"withdrawal"}}
+	mockWalletService.EXPECT().GetWallet(mock.Anything, uint(1)).Return(&walletentity.Wallet{ID: 1}, nil).Once()
+	mockRepository.EXPECT().StreamWalletTransactions(mock.Anything, expectedFilter, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ entity.TransactionFilter, fn func(*entity.WalletTransaction) error) error {
+			return fn(&entity.WalletTransaction{EntryID: 1})
+		}).Once()
+
+	var exported []uint
+	err := s.ExportTransactions(context.Background(), 1, &request.ExportTransactionsRequest{
+		TransactionFilterRequest: request.TransactionFilterRequest{Type: []string{"withdrawal"}, Order: "asc"},
+		Format:                   request.ExportFormatCSV,
+	}, func(item *entity.WalletTransaction) error {
+		exported = append(exported, item.EntryID)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{1}, exported)
+}
+
+func TestService_CreateHold(t *testing.T) {
+	conf := config.LedgerConfig{HoldTTL: 15 * time.Minute, HoldMaxTTL: time.Hour}
+	inHalfAnHour := time.Now().Add(30 * time.Minute)
+	inTwoHours := time.Now().Add(2 * time.Hour)
+	anHourAgo := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name              string
+		request           *request.HoldRequest
+		walletErr         error
+		mockRepository    bool
+		expectedExpiresAt *time.Time
+		mockError         error
+		expectedError     error
+	}{
+		{
+			name:           "when expiry is omitted then should hold until default expiry",
+			request:        &request.HoldRequest{Asset: "btc", Amount: "0.000001", Reference: "order-1"},
+			mockRepository: true,
+		},
+		{
+			name:              "when expiry is given then should hold until expiry",
+			request:           &request.HoldRequest{Asset: "BTC", Amount: "0.000001", ExpiresAt: &inHalfAnHour},
+			mockRepository:    true,
+			expectedExpiresAt: &inHalfAnHour,
+		},
+		{
+			name:           "when available balance is insufficient then should return error",
+			request:        &request.HoldRequest{Asset: "BTC", Amount: "0.000001"},
+			mockRepository: true,
+			mockError:      ErrInsufficientFunds,
+			expectedError:  ErrInsufficientFunds,
+		},
+		{
+			name:          "when expiry is in the past then should return error",
+			request:       &request.HoldRequest{Asset: "BTC", Amount: "0.000001", ExpiresAt: &anHourAgo},
+			expectedError: ErrInvalidExpiry,
+		},
+		{
+			name:          "when expiry exceeds maximum then should return error",
+			request:       &request.HoldRequest{Asset: "BTC", Amount: "0.000001", ExpiresAt: &inTwoHours},
+			expectedError: ErrInvalidExpiry,
+		},
+		{
+			name:          "when asset is not registered then should return error",
+			request:       &request.HoldRequest{Asset: "DOGE", Amount: "0.000001"},
+			expectedError: asset.ErrUnknownAsset,
+		},
+		{
+			name:          "when wallet does not exist then should return error",
+			request:       &request.HoldRequest{Asset: "BTC", Amount: "0.000001"},
+			walletErr:     wallet.ErrWalletNotFound,
+			expectedError: wallet.ErrWalletNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := ledgermock.NewMockLedgerRepository(t)
+			mockWalletService := walletmock.NewMockWalletService(t)
+			s := NewService(mockRepository, mockWalletService, mockAssets(t), conf)
+
+			if tt.walletErr != nil || tt.mockRepository {
+				var mockWallet *walletentity.Wallet
+				if tt.walletErr == nil {
+					mockWallet = &walletentity.Wallet{ID: 1}
+				}
+				mockWalletService.EXPECT().GetWallet(mock.Anything, uint(1)).Return(mockWallet, tt.walletErr).Once()
+			}
+			if tt.mockRepository {
+				mockRepository.EXPECT().CreateHold(mock.Anything, mock.MatchedBy(func(hold *entity.Hold) bool {
+					expiresAt := time.Now().Add(conf.HoldTTL)
+					if tt.expectedExpiresAt != nil {
+						expiresAt = *tt.expectedExpiresAt
+					}
+					return hold.WalletID == 1 && hold.Asset == "BTC" && hold.Amount.Cmp(amount.New(100)) == 0 &&
+						hold.Reference == tt.request.Reference && hold.ExpiresAt.Sub(expiresAt).Abs() < time.Second
+				})).RunAndReturn(func(_ context.Context, hold *entity.Hold) (*entity.Hold, error) {
+					if tt.mockError != nil {
+						return nil, tt.mockError
+					}
+					return hold, nil
+				}).Once()
+			}
+
+			result, err := s.CreateHold(context.Background(), 1, tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "BTC", result.Asset)
+			}
+		})
+	}
+}
+
+func TestService_SettleHold(t *testing.T) {
+	tests := []struct {
+		name          string
+		capture       bool
+		walletErr     error
+		mockReturn    *entity.Hold
+		mockError     error
+		expectedError error
+	}{
+		{
+			name:       "when hold is captured then should return captured hold",
+			capture:    true,
+			mockReturn: &entity.Hold{ID: 2, WalletID: 1, Status: entity.HoldStatusCaptured},
+		},
+		{
+			name:       "when hold is released then should return released hold",
+			mockReturn: &entity.Hold{ID: 2, WalletID: 1, Status: entity.HoldStatusReleased},
+		},
+		{
+			name:          "when hold has expired then should return error",
+			capture:       true,
+			mockError:     ErrHoldExpired,
+			expectedError: ErrHoldExpired,
+		},
+		{
+			name:          "when hold has been settled then should return error",
+			mockError:     ErrHoldSettled,
+			expectedError: ErrHoldSettled,
+		},
+		{
+			name:          "when wallet is not visible then should return error",
+			capture:       true,
+			walletErr:     wallet.ErrWalletNotFound,
+			expectedError: wallet.ErrWalletNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := ledgermock.NewMockLedgerRepository(t)
+			mockWalletService := walletmock.NewMockWalletService(t)
+			s := NewService(mockRepository, mockWalletService, assetmock.NewMockAssetService(t), config.LedgerConfig{})
+
+			var mockWallet *walletentity.Wallet
+			if tt.walletErr == nil {
+				mockWallet = &walletentity.Wallet{ID: 1}
+			}
+			mockWalletService.EXPECT().GetWallet(mock.Anything, uint(1)).Return(mockWallet, tt.walletErr).Once()
+
+			var result *entity.Hold
+			var err error
+			if tt.capture {
+				if tt.walletErr == nil {
+					mockRepository.EXPECT().CaptureHold(mock.Anything, uint(1), uint(2), mock.Anything).
+						Return(tt.mockReturn, tt.mockError).Once()
+				}
+				result, err = s.CaptureHold(context.Background(), 1, 2)
+			} else {
+				if tt.walletErr == nil {
+					mockRepository.EXPECT().ReleaseHold(mock.Anything, uint(1), uint(2), mock.Anything).
+						Return(tt.mockReturn, tt.mockError).Once()
+				}
+				result, err = s.ReleaseHold(context.Background(), 1, 2)
+			}
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockReturn, result)
+			}
+		})
+	}
+}
+
+// mockAssets resolves BTC and ETH, rejects XRP as disabled and any other asset as not registered.
+func mockAssets(t *testing.T) *assetmock.MockAssetService {
+	mockAssetService := assetmock.NewMockAssetService(t)
+	mockAssetService.EXPECT().ResolveAsset(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, code string) (*assetentity.Asset, error) {
+			switch asset.NormalizeCode(code) {
+			case "BTC":
+				return &assetentity.Asset{Code: "BTC", Network: "bitcoin", Decimals: 8, Enabled: true}, nil
+			case "ETH":
+				return &assetentity.Asset{Code: "ETH", Network: "ethereum", Decimals: 18, Enabled: true}, nil
+			case "XRP":
+				return nil, asset.ErrAssetDisabled
+			default:
+				return nil, asset.ErrUnknownAsset
+			}
+		}).Maybe()
+
+	return mockAssetService
+}
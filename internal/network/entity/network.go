@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+type Network struct {
+	Code          string      `json:"code" gorm:"primaryKey"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     null.Time   `json:"updated_at"`
+	DisplayName   string      `json:"display_name"`
+	ChainID       null.String `json:"chain_id"`
+	AddressFormat string      `json:"address_format"`
+	MemoFormat    string      `json:"memo_format"`
+	Testnet       bool        `json:"testnet"`
+	Enabled       bool        `json:"enabled"`
+}
+
+// NetworkChanges holds the mutable fields of a network; nil fields are left untouched.
+type NetworkChanges struct {
+	DisplayName   *string
+	ChainID       *null.String
+	AddressFormat *string
+	MemoFormat    *string
+	Testnet       *bool
+	Enabled       *bool
+}
+
+// NetworkFilter narrows down a network listing.
+type NetworkFilter struct {
+	Enabled *bool
+	Testnet *bool
+}
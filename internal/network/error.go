@@ -0,0 +1,10 @@
+package network
+
+import "github.com/safayildirim/wallet-management-service/internal/apperror"
+
+var (
+	ErrNetworkNotFound  = apperror.NotFound("network_not_found", "network not found")
+	ErrDuplicateNetwork = apperror.AlreadyExists("network_already_exists", "network already exists")
+	ErrNetworkInUse     = apperror.Conflict("network_in_use", "network is used by existing wallets or assets")
+	ErrFormatInUse      = apperror.Conflict("network_format_in_use", "format cannot change while the network is in use")
+)
@@ -0,0 +1,143 @@
+package network
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/network/request"
+	"net/http"
+)
+
+type Handler struct {
+	networkService Service
+}
+
+func NewHandler(networkService Service) *Handler {
+	return &Handler{networkService: networkService}
+}
+
+func (h Handler) RegisterRoutes(e *echo.Group) {
+	e.POST("/networks", h.CreateNetwork)
+	e.GET("/networks", h.ListNetworks)
+	e.GET("/networks/:code", h.GetNetwork)
+	e.PATCH("/networks/:code", h.UpdateNetwork)
+	e.DELETE("/networks/:code", h.DeleteNetwork)
+}
+
+// CreateNetwork handles the registration of a new network.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 201 Created with the created network on success.
+//   - 400 Bad Request if the request payload is invalid or the address or memo format is not supported.
+//   - 403 Forbidden if the caller is scoped to an owner.
+//   - 409 Conflict if a network with the same code exists.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) CreateNetwork(ctx echo.Context) error {
+	var req request.CreateNetworkRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	network, err := h.networkService.CreateNetwork(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, Response{Data: network})
+}
+
+// ListNetworks returns the registered networks, optionally filtered.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the matching networks on success.
+//   - 400 Bad Request if the query parameters are invalid.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) ListNetworks(ctx echo.Context) error {
+	var req request.ListNetworksRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	networks, err := h.networkService.ListNetworks(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: networks})
+}
+
+// GetNetwork retrieves a network by its code.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the network on success.
+//   - 404 Not Found if the network does not exist.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) GetNetwork(ctx echo.Context) error {
+	network, err := h.networkService.GetNetwork(ctx.Request().Context(), ctx.Param("code"))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: network})
+}
+
+// UpdateNetwork partially updates a network, e.g. to disable it.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the updated network on success.
+//   - 400 Bad Request if the request payload is invalid or the address or memo format is not supported.
+//   - 403 Forbidden if the caller is scoped to an owner.
+//   - 404 Not Found if the network does not exist.
+//   - 409 Conflict if the address or memo format changes while the network is in use.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) UpdateNetwork(ctx echo.Context) error {
+	var req request.UpdateNetworkRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	network, err := h.networkService.UpdateNetwork(ctx.Request().Context(), ctx.Param("code"), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: network})
+}
+
+// DeleteNetwork deletes a network that no wallet or asset refers to.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 204 No Content on success.
+//   - 403 Forbidden if the caller is scoped to an owner.
+//   - 404 Not Found if the network does not exist.
+//   - 409 Conflict if wallets or assets still refer to the network.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) DeleteNetwork(ctx echo.Context) error {
+	if err := h.networkService.DeleteNetwork(ctx.Request().Context(), ctx.Param("code")); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,277 @@
+package network
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/network/entity"
+	networkmock "github.com/safayildirim/wallet-management-service/internal/network/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler_CreateNetwork(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		body                 string
+		mockService          bool
+		mockReturn           *entity.Network
+		mockError            error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:        "when valid request body is provided then should create network",
+			body:        `{"code":"ethereum","display_name":"Ethereum","chain_id":"1","address_format":"evm"}`,
+			mockService: true,
+			mockReturn: &entity.Network{
+				Code: "ethereum", DisplayName: "Ethereum", AddressFormat: "evm", Enabled: true,
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:                 "when code has invalid characters then should return bad request",
+			body:                 `{"code":"Ethereum Mainnet","display_name":"Ethereum","address_format":"evm"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "code: must be in a valid format",
+		},
+		{
+			name:                 "when address format is missing then should return bad request",
+			body:                 `{"code":"ethereum","display_name":"Ethereum"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "address_format: cannot be blank",
+		},
+		{
+			name:                 "when network already exists then should return conflict",
+			body:                 `{"code":"ethereum","display_name":"Ethereum","address_format":"evm"}`,
+			mockService:          true,
+			mockError:            ErrDuplicateNetwork,
+			expectedStatus:       http.StatusConflict,
+			expectErr:            true,
+			expectedErrorMessage: "network already exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := networkmock.NewMockNetworkService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().CreateNetwork(mock.Anything, mock.Anything).
+					Return(tt.mockReturn, tt.mockError).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/networks", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.CreateNetwork(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_GetNetwork(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		code                 string
+		mockReturn           *entity.Network
+		mockError            error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when network exists then should return network",
+			code:           "bitcoin",
+			mockReturn:     &entity.Network{Code: "bitcoin", AddressFormat: "bitcoin", Enabled: true},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when network does not exist then should return not found",
+			code:                 "dogecoin",
+			mockError:            ErrNetworkNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "network not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := networkmock.NewMockNetworkService(t)
+			handler := NewHandler(mockService)
+
+			mockService.EXPECT().GetNetwork(mock.Anything, tt.code).Return(tt.mockReturn, tt.mockError).Once()
+
+			req := httptest.NewRequest(http.MethodGet, "/networks/:code", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/networks/:code")
+			ctx.SetParamNames("code")
+			ctx.SetParamValues(tt.code)
+
+			err := handler.GetNetwork(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_UpdateNetwork(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		body                 string
+		mockService          bool
+		mockReturn           *entity.Network
+		mockError            error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when network is disabled then should return updated network",
+			body:           `{"enabled":false}`,
+			mockService:    true,
+			mockReturn:     &entity.Network{Code: "sepolia", AddressFormat: "evm", Testnet: true},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when no field is provided then should return bad request",
+			body:                 `{}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "at least one field must be provided",
+		},
+		{
+			name:                 "when format of network in use changes then should return conflict",
+			body:                 `{"address_format":"tron"}`,
+			mockService:          true,
+			mockError:            ErrFormatInUse,
+			expectedStatus:       http.StatusConflict,
+			expectErr:            true,
+			expectedErrorMessage: "format cannot change while the network is in use",
+		},
+		{
+			name:                 "when service returns error then should return internal server error",
+			body:                 `{"display_name":"Sepolia"}`,
+			mockService:          true,
+			mockError:            errors.New("service error"),
+			expectedStatus:       http.StatusInternalServerError,
+			expectErr:            true,
+			expectedErrorMessage: "service error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := networkmock.NewMockNetworkService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().UpdateNetwork(mock.Anything, "sepolia", mock.Anything).
+					Return(tt.mockReturn, tt.mockError).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/networks/:code", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/networks/:code")
+			ctx.SetParamNames("code")
+			ctx.SetParamValues("sepolia")
+
+			err := handler.UpdateNetwork(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_DeleteNetwork(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		mockError            error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when network is unused then should delete network",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:                 "when network is used by wallets then should return conflict",
+			mockError:            ErrNetworkInUse,
+			expectedStatus:       http.StatusConflict,
+			expectErr:            true,
+			expectedErrorMessage: "network is used by existing wallets",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := networkmock.NewMockNetworkService(t)
+			handler := NewHandler(mockService)
+
+			mockService.EXPECT().DeleteNetwork(mock.Anything, "sepolia").Return(tt.mockError).Once()
+
+			req := httptest.NewRequest(http.MethodDelete, "/networks/:code", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/networks/:code")
+			ctx.SetParamNames("code")
+			ctx.SetParamValues("sepolia")
+
+			err := handler.DeleteNetwork(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package network
+
+type Response struct {
+	Data any `json:"data"`
+}
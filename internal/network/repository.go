@@ -0,0 +1,169 @@
+package network
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/network/entity"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
+	"time"
+)
+
+type Repository interface {
+	CreateNetwork(ctx context.Context, entity *entity.Network) (*entity.Network, error)
+	GetNetwork(ctx context.Context, code string) (*entity.Network, error)
+	ListNetworks(ctx context.Context, filter entity.NetworkFilter) ([]*entity.Network, error)
+	UpdateNetwork(ctx context.Context, code string, changes entity.NetworkChanges) (*entity.Network, error)
+	DeleteNetwork(ctx context.Context, code string) error
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) CreateNetwork(ctx context.Context, entity *entity.Network) (*entity.Network, error) {
+	err := r.db.WithContext(ctx).Create(entity).Error
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, ErrDuplicateNetwork
+		}
+
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+func (r *repository) GetNetwork(ctx context.Context, code string) (*entity.Network, error) {
+	var item entity.Network
+	err := r.db.WithContext(ctx).Where("code = ?", code).First(&item).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNetworkNotFound
+		}
+
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (r *repository) ListNetworks(ctx context.Context, filter entity.NetworkFilter) ([]*entity.Network, error) {
+	query := r.db.WithContext(ctx).Model(&entity.Network{})
+
+	if filter.Enabled != nil {
+		query = query.Where("enabled = ?", *filter.Enabled)
+	}
+	if filter.Testnet != nil {
+		query = query.Where("testnet = ?", *filter.Testnet)
+	}
+
+	var items []*entity.Network
+	err := query.Order("code").Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *repository) UpdateNetwork(ctx context.Context, code string, changes entity.NetworkChanges) (*entity.Network, error) {
+	updates := map[string]interface{}{
+		"updated_at": time.Now(),
+	}
+	if changes.DisplayName != nil {
+		updates["display_name"] = *changes.DisplayName
+	}
+	if changes.ChainID != nil {
+		updates["chain_id"] = *changes.ChainID
+	}
+	if changes.AddressFormat != nil {
+		updates["address_format"] = *changes.AddressFormat
+	}
+	if changes.MemoFormat != nil {
+		updates["memo_format"] = *changes.MemoFormat
+	}
+	if changes.Testnet != nil {
+		updates["testnet"] = *changes.Testnet
+	}
+	if changes.Enabled != nil {
+		updates["enabled"] = *changes.Enabled
+	}
+
+	var item entity.Network
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the network so that no address is added on it while its formats are checked
+		var current entity.Network
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("code = ?", code).First(&current).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNetworkNotFound
+			}
+
+			return err
+		}
+
+		// Stored addresses are in the canonical form of the current formats, which a new
+		// format would no longer produce for lookups and duplicate checks
+		if changes.AddressFormat != nil && *changes.AddressFormat != current.AddressFormat {
+			if err := rejectIfUsed(tx, "address_format",
+				"SELECT 1 FROM wallet_addresses WHERE network = ? UNION ALL SELECT 1 FROM assets WHERE network = ?",
+				code, code,
+			); err != nil {
+				return err
+			}
+		}
+		if changes.MemoFormat != nil && *changes.MemoFormat != current.MemoFormat {
+			if err := rejectIfUsed(tx, "memo_format",
+				"SELECT 1 FROM wallet_addresses WHERE network = ? AND memo <> ''", code,
+			); err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&item).Clauses(clause.Returning{}).Where("code = ?", code).Updates(updates).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// rejectIfUsed returns ErrFormatInUse, pointing at the given field, if the query finds any row.
+func rejectIfUsed(tx *gorm.DB, field string, query string, args ...interface{}) error {
+	var used bool
+	err := tx.Raw("SELECT EXISTS ("+query+")", args...).Scan(&used).Error
+	if err != nil {
+		return err
+	}
+
+	if used {
+		return ErrFormatInUse.WithFields(map[string]string{field: "cannot change while the network is in use"})
+	}
+
+	return nil
+}
+
+// DeleteNetwork removes a network that no wallet or asset refers to; networks in use should be disabled instead.
+func (r *repository) DeleteNetwork(ctx context.Context, code string) error {
+	result := r.db.WithContext(ctx).Where("code = ?", code).Delete(&entity.Network{})
+	if result.Error != nil {
+		if strings.Contains(result.Error.Error(), "foreign key") {
+			return ErrNetworkInUse
+		}
+
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrNetworkNotFound
+	}
+
+	return nil
+}
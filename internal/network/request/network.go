@@ -0,0 +1,60 @@
+package request
+
+import (
+	"regexp"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+)
+
+var codePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+type CreateNetworkRequest struct {
+	Code          string  `json:"code"`
+	DisplayName   string  `json:"display_name"`
+	ChainID       *string `json:"chain_id"`
+	AddressFormat string  `json:"address_format"`
+	MemoFormat    string  `json:"memo_format"`
+	Testnet       bool    `json:"testnet"`
+	Enabled       *bool   `json:"enabled"`
+}
+
+func (r CreateNetworkRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Code, validation.Required, validation.Length(1, 32), validation.Match(codePattern)),
+		validation.Field(&r.DisplayName, validation.Required, validation.Length(1, 64)),
+		validation.Field(&r.ChainID, validation.Length(1, 64)),
+		validation.Field(&r.AddressFormat, validation.Required),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "network create validation error")
+}
+
+type UpdateNetworkRequest struct {
+	DisplayName   *string `json:"display_name"`
+	ChainID       *string `json:"chain_id"`
+	AddressFormat *string `json:"address_format"`
+	MemoFormat    *string `json:"memo_format"`
+	Testnet       *bool   `json:"testnet"`
+	Enabled       *bool   `json:"enabled"`
+}
+
+func (r UpdateNetworkRequest) Validate() error {
+	if r.DisplayName == nil && r.ChainID == nil && r.AddressFormat == nil && r.MemoFormat == nil &&
+		r.Testnet == nil && r.Enabled == nil {
+		return errors.New("network update validation error: at least one field must be provided")
+	}
+
+	fields := []*validation.FieldRules{
+		validation.Field(&r.DisplayName, validation.Length(1, 64)),
+		validation.Field(&r.ChainID, validation.Length(0, 64)),
+		validation.Field(&r.AddressFormat, validation.Length(1, 32)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "network update validation error")
+}
+
+type ListNetworksRequest struct {
+	Enabled *bool `json:"enabled" query:"enabled"`
+	Testnet *bool `json:"testnet" query:"testnet"`
+}
@@ -0,0 +1,157 @@
+package network
+
+import (
+	"context"
+	"github.com/safayildirim/wallet-management-service/internal/address"
+	"github.com/safayildirim/wallet-management-service/internal/auth"
+	"github.com/safayildirim/wallet-management-service/internal/network/entity"
+	"github.com/safayildirim/wallet-management-service/internal/network/request"
+	"gopkg.in/guregu/null.v3"
+	"strings"
+)
+
+type Service interface {
+	CreateNetwork(ctx context.Context, request *request.CreateNetworkRequest) (*entity.Network, error)
+	GetNetwork(ctx context.Context, code string) (*entity.Network, error)
+	ListNetworks(ctx context.Context, request *request.ListNetworksRequest) ([]*entity.Network, error)
+	UpdateNetwork(ctx context.Context, code string, request *request.UpdateNetworkRequest) (*entity.Network, error)
+	DeleteNetwork(ctx context.Context, code string) error
+}
+
+type service struct {
+	networkRepository Repository
+	addressRegistry   *address.Registry
+}
+
+func NewService(networkRepository Repository, addressRegistry *address.Registry) Service {
+	return &service{networkRepository: networkRepository, addressRegistry: addressRegistry}
+}
+
+// CreateNetwork registers a new network.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - request: Request object containing the network details.
+//
+// Returns:
+//   - The created network entity.
+//   - An error if the caller is scoped to an owner, the address or memo format is not supported,
+//     the code is taken or creation fails.
+func (s *service) CreateNetwork(ctx context.Context, request *request.CreateNetworkRequest) (*entity.Network, error) {
+	if err := auth.RequireUnscoped(ctx); err != nil {
+		return nil, err
+	}
+
+	if !s.addressRegistry.Supports(request.AddressFormat) {
+		return nil, address.ErrUnsupportedAddressFormat.WithFields(map[string]string{"address_format": "is not supported"})
+	}
+	if request.MemoFormat != "" && !s.addressRegistry.SupportsMemo(request.MemoFormat) {
+		return nil, address.ErrUnsupportedMemoFormat.WithFields(map[string]string{"memo_format": "is not supported"})
+	}
+
+	item := entity.Network{
+		Code:          NormalizeCode(request.Code),
+		DisplayName:   request.DisplayName,
+		ChainID:       null.StringFromPtr(request.ChainID),
+		AddressFormat: request.AddressFormat,
+		MemoFormat:    request.MemoFormat,
+		Testnet:       request.Testnet,
+		Enabled:       true,
+	}
+	if request.Enabled != nil {
+		item.Enabled = *request.Enabled
+	}
+
+	return s.networkRepository.CreateNetwork(ctx, &item)
+}
+
+// GetNetwork retrieves a network by its code.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - code: The network code, matched case-insensitively.
+//
+// Returns:
+//   - The network entity if found.
+//   - An error if the network does not exist or retrieval fails.
+func (s *service) GetNetwork(ctx context.Context, code string) (*entity.Network, error) {
+	return s.networkRepository.GetNetwork(ctx, NormalizeCode(code))
+}
+
+// ListNetworks returns all networks matching the request filters, ordered by code.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - request: Request object containing the enabled and testnet filters.
+//
+// Returns:
+//   - The matching networks.
+//   - An error if retrieval fails.
+func (s *service) ListNetworks(ctx context.Context, request *request.ListNetworksRequest) ([]*entity.Network, error) {
+	return s.networkRepository.ListNetworks(ctx, entity.NetworkFilter{
+		Enabled: request.Enabled,
+		Testnet: request.Testnet,
+	})
+}
+
+// UpdateNetwork partially updates a network.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - code: The network code.
+//   - request: Request object containing the fields to change.
+//
+// Returns:
+//   - The updated network entity.
+//   - An error if the caller is scoped to an owner, the address or memo format is not supported,
+//     the network does not exist, a format changes while the network is in use or the update fails.
+func (s *service) UpdateNetwork(ctx context.Context, code string, request *request.UpdateNetworkRequest) (*entity.Network, error) {
+	if err := auth.RequireUnscoped(ctx); err != nil {
+		return nil, err
+	}
+
+	if request.AddressFormat != nil && !s.addressRegistry.Supports(*request.AddressFormat) {
+		return nil, address.ErrUnsupportedAddressFormat.WithFields(map[string]string{"address_format": "is not supported"})
+	}
+	// An empty memo format turns memos off for new wallets
+	if request.MemoFormat != nil && *request.MemoFormat != "" && !s.addressRegistry.SupportsMemo(*request.MemoFormat) {
+		return nil, address.ErrUnsupportedMemoFormat.WithFields(map[string]string{"memo_format": "is not supported"})
+	}
+
+	changes := entity.NetworkChanges{
+		DisplayName:   request.DisplayName,
+		AddressFormat: request.AddressFormat,
+		MemoFormat:    request.MemoFormat,
+		Testnet:       request.Testnet,
+		Enabled:       request.Enabled,
+	}
+	if request.ChainID != nil {
+		// An empty chain id clears it
+		chainID := null.NewString(*request.ChainID, *request.ChainID != "")
+		changes.ChainID = &chainID
+	}
+
+	return s.networkRepository.UpdateNetwork(ctx, NormalizeCode(code), changes)
+}
+
+// DeleteNetwork deletes a network by its code.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - code: The network code.
+//
+// Returns:
+//   - An error if the caller is scoped to an owner, the network does not exist, is used by
+//     wallets or assets or deletion fails.
+func (s *service) DeleteNetwork(ctx context.Context, code string) error {
+	if err := auth.RequireUnscoped(ctx); err != nil {
+		return err
+	}
+
+	return s.networkRepository.DeleteNetwork(ctx, NormalizeCode(code))
+}
+
+// NormalizeCode returns the lookup form of a network code.
+func NormalizeCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
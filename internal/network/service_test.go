@@ -0,0 +1,195 @@
+package network
+
+import (
+	"context"
+	"github.com/safayildirim/wallet-management-service/internal/address"
+	"github.com/safayildirim/wallet-management-service/internal/auth"
+	"github.com/safayildirim/wallet-management-service/internal/network/entity"
+	networkmock "github.com/safayildirim/wallet-management-service/internal/network/mock"
+	"github.com/safayildirim/wallet-management-service/internal/network/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/guregu/null.v3"
+	"testing"
+)
+
+func TestService_CreateNetwork(t *testing.T) {
+	chainID := "137"
+	disabled := false
+
+	tests := []struct {
+		name           string
+		request        *request.CreateNetworkRequest
+		mockRepository bool
+		expectedEntity *entity.Network
+		expectedError  error
+	}{
+		{
+			name: "when request is valid then should create enabled network",
+			request: &request.CreateNetworkRequest{
+				Code: " Polygon ", DisplayName: "Polygon PoS", ChainID: &chainID, AddressFormat: address.FormatEVM,
+			},
+			mockRepository: true,
+			expectedEntity: &entity.Network{
+				Code: "polygon", DisplayName: "Polygon PoS", ChainID: null.StringFrom("137"),
+				AddressFormat: address.FormatEVM, Enabled: true,
+			},
+		},
+		{
+			name: "when network is created disabled then should keep it disabled",
+			request: &request.CreateNetworkRequest{
+				Code: "bitcoin-testnet", DisplayName: "Bitcoin Testnet", AddressFormat: address.FormatBitcoinTestnet,
+				Testnet: true, Enabled: &disabled,
+			},
+			mockRepository: true,
+			expectedEntity: &entity.Network{
+				Code: "bitcoin-testnet", DisplayName: "Bitcoin Testnet", AddressFormat: address.FormatBitcoinTestnet,
+				Testnet: true,
+			},
+		},
+		{
+			name: "when network uses memos then should keep memo format",
+			request: &request.CreateNetworkRequest{
+				Code: "xrp", DisplayName: "XRP Ledger", AddressFormat: address.FormatXRP,
+				MemoFormat: address.MemoFormatDestinationTag,
+			},
+			mockRepository: true,
+			expectedEntity: &entity.Network{
+				Code: "xrp", DisplayName: "XRP Ledger", AddressFormat: address.FormatXRP,
+				MemoFormat: address.MemoFormatDestinationTag, Enabled: true,
+			},
+		},
+		{
+			name: "when address format is not supported then should return error",
+			request: &request.CreateNetworkRequest{
+				Code: "dogecoin", DisplayName: "Dogecoin", AddressFormat: "dogecoin",
+			},
+			expectedError: address.ErrUnsupportedAddressFormat,
+		},
+		{
+			name: "when memo format is not supported then should return error",
+			request: &request.CreateNetworkRequest{
+				Code: "monero", DisplayName: "Monero", AddressFormat: address.FormatEVM, MemoFormat: "payment-id",
+			},
+			expectedError: address.ErrUnsupportedMemoFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := networkmock.NewMockNetworkRepository(t)
+			s := NewService(mockRepository, address.DefaultRegistry())
+
+			if tt.mockRepository {
+				mockRepository.EXPECT().CreateNetwork(mock.Anything, tt.expectedEntity).
+					Return(tt.expectedEntity, nil).Once()
+			}
+
+			result, err := s.CreateNetwork(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedEntity, result)
+			}
+		})
+	}
+}
+
+func TestService_GetNetwork(t *testing.T) {
+	mockRepository := networkmock.NewMockNetworkRepository(t)
+	s := NewService(mockRepository, address.DefaultRegistry())
+
+	expected := &entity.Network{Code: "ethereum", AddressFormat: address.FormatEVM, Enabled: true}
+	mockRepository.EXPECT().GetNetwork(mock.Anything, "ethereum").Return(expected, nil).Once()
+
+	result, err := s.GetNetwork(context.Background(), " Ethereum")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestService_UpdateNetwork(t *testing.T) {
+	empty := ""
+	enabled := true
+	unsupported := "dogecoin"
+	evm := address.FormatEVM
+	cleared := null.NewString("", false)
+
+	tests := []struct {
+		name            string
+		request         *request.UpdateNetworkRequest
+		mockRepository  bool
+		expectedChanges entity.NetworkChanges
+		expectedError   error
+	}{
+		{
+			name:            "when chain id is empty then should clear it",
+			request:         &request.UpdateNetworkRequest{ChainID: &empty, Enabled: &enabled},
+			mockRepository:  true,
+			expectedChanges: entity.NetworkChanges{ChainID: &cleared, Enabled: &enabled},
+		},
+		{
+			name:          "when address format is not supported then should return error",
+			request:       &request.UpdateNetworkRequest{AddressFormat: &unsupported},
+			expectedError: address.ErrUnsupportedAddressFormat,
+		},
+		{
+			name:            "when format of network in use changes then should return error",
+			request:         &request.UpdateNetworkRequest{AddressFormat: &evm},
+			mockRepository:  true,
+			expectedChanges: entity.NetworkChanges{AddressFormat: &evm},
+			expectedError:   ErrFormatInUse,
+		},
+		{
+			name:            "when network does not exist then should return error",
+			request:         &request.UpdateNetworkRequest{Enabled: &enabled},
+			mockRepository:  true,
+			expectedChanges: entity.NetworkChanges{Enabled: &enabled},
+			expectedError:   ErrNetworkNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := networkmock.NewMockNetworkRepository(t)
+			s := NewService(mockRepository, address.DefaultRegistry())
+
+			expected := &entity.Network{Code: "tron"}
+			if tt.mockRepository {
+				var mockReturn *entity.Network
+				if tt.expectedError == nil {
+					mockReturn = expected
+				}
+				mockRepository.EXPECT().UpdateNetwork(mock.Anything, "tron", tt.expectedChanges).
+					Return(mockReturn, tt.expectedError).Once()
+			}
+
+			result, err := s.UpdateNetwork(context.Background(), "TRON", tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, expected, result)
+			}
+		})
+	}
+}
+
+func TestService_ScopedCaller(t *testing.T) {
+	mockRepository := networkmock.NewMockNetworkRepository(t)
+	s := NewService(mockRepository, address.DefaultRegistry())
+	ctx := auth.WithScope(context.Background(), auth.Scope{OwnerID: "customer-1"})
+	enabled := false
+
+	_, err := s.CreateNetwork(ctx, &request.CreateNetworkRequest{Code: "tron", DisplayName: "TRON", AddressFormat: address.FormatTron})
+	assert.ErrorIs(t, err, auth.ErrScopeForbidden)
+
+	_, err = s.UpdateNetwork(ctx, "tron", &request.UpdateNetworkRequest{Enabled: &enabled})
+	assert.ErrorIs(t, err, auth.ErrScopeForbidden)
+
+	err = s.DeleteNetwork(ctx, "tron")
+	assert.ErrorIs(t, err, auth.ErrScopeForbidden)
+}
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"encoding/json"
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+// Event is a domain event recorded in the outbox in the same transaction as the change it
+// describes. The relay publishes it afterwards and marks it as published.
+type Event struct {
+	ID            uint            `json:"id"`
+	CreatedAt     time.Time       `json:"created_at"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	OwnerID       string          `json:"owner_id"`
+	Type          string          `json:"type"`
+	Payload       json.RawMessage `json:"payload" gorm:"serializer:json"`
+	PublishedAt   null.Time       `json:"published_at"`
+}
+
+func (Event) TableName() string {
+	return "outbox"
+}
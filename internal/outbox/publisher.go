@@ -0,0 +1,64 @@
+package outbox
+
+import (
+	"context"
+	"github.com/safayildirim/wallet-management-service/internal/outbox/entity"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/segmentio/kafka-go"
+	"strconv"
+	"time"
+)
+
+const (
+	HeaderEventID       = "event_id"
+	HeaderEventType     = "event_type"
+	HeaderAggregateType = "aggregate_type"
+)
+
+// Publisher delivers outbox events to the consumers downstream.
+type Publisher interface {
+	Publish(ctx context.Context, events []*entity.Event) error
+	Close() error
+}
+
+type kafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaPublisher returns a publisher writing events to the configured topic, keyed by
+// their aggregate id. Keys are hashed to partitions, so the events of an aggregate are kept
+// in order.
+func NewKafkaPublisher(conf config.KafkaConfig) Publisher {
+	return &kafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(conf.Brokers...),
+			Topic:        conf.WalletEventsTopic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			BatchTimeout: 10 * time.Millisecond,
+		},
+	}
+}
+
+// Publish writes the events and waits until the brokers have acknowledged all of them.
+func (p *kafkaPublisher) Publish(ctx context.Context, events []*entity.Event) error {
+	messages := make([]kafka.Message, 0, len(events))
+	for _, event := range events {
+		messages = append(messages, kafka.Message{
+			Key:   []byte(event.AggregateID),
+			Value: event.Payload,
+			Time:  event.CreatedAt,
+			Headers: []kafka.Header{
+				{Key: HeaderEventID, Value: []byte(strconv.FormatUint(uint64(event.ID), 10))},
+				{Key: HeaderEventType, Value: []byte(event.Type)},
+				{Key: HeaderAggregateType, Value: []byte(event.AggregateType)},
+			},
+		})
+	}
+
+	return p.writer.WriteMessages(ctx, messages...)
+}
+
+func (p *kafkaPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,105 @@
+package outbox
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	outboxmock "github.com/safayildirim/wallet-management-service/internal/outbox/mock"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/safayildirim/wallet-management-service/pkg/worker"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewPurger(t *testing.T) {
+	tests := []struct {
+		name        string
+		conf        config.OutboxConfig
+		expectedErr error
+	}{
+		{
+			name: "when interval and batch size are positive then should create purger",
+			conf: config.OutboxConfig{PurgeInterval: time.Hour, PurgeBatchSize: 500},
+		},
+		{
+			name:        "when interval is not positive then should return error",
+			conf:        config.OutboxConfig{PurgeInterval: 0, PurgeBatchSize: 500},
+			expectedErr: worker.ErrInvalidInterval,
+		},
+		{
+			name:        "when batch size is not positive then should return error",
+			conf:        config.OutboxConfig{PurgeInterval: time.Hour, PurgeBatchSize: 0},
+			expectedErr: worker.ErrInvalidBatchSize,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewPurger(outboxmock.NewMockOutboxRepository(t), tt.conf)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, p)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, p)
+			}
+		})
+	}
+}
+
+func TestPurger_Purge(t *testing.T) {
+	cutoff := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		batches       []int64
+		mockError     error
+		expectedTotal int64
+		expectErr     bool
+	}{
+		{
+			name:          "when backlog spans several batches then should keep purging until a partial batch",
+			batches:       []int64{2, 2, 1},
+			expectedTotal: 5,
+		},
+		{
+			name:          "when nothing is expired then should purge nothing",
+			batches:       []int64{0},
+			expectedTotal: 0,
+		},
+		{
+			name:          "when repository returns an error then should stop and return error",
+			batches:       []int64{2},
+			mockError:     errors.New("repository error"),
+			expectedTotal: 2,
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := outboxmock.NewMockOutboxRepository(t)
+			p, err := NewPurger(mockRepository, config.OutboxConfig{PurgeInterval: time.Hour, PurgeBatchSize: 2})
+			assert.NoError(t, err)
+
+			for _, purged := range tt.batches {
+				mockRepository.EXPECT().PurgeEvents(context.Background(), cutoff, 2).
+					Return(purged, nil).Once()
+			}
+			if tt.mockError != nil {
+				mockRepository.EXPECT().PurgeEvents(context.Background(), cutoff, 2).
+					Return(0, tt.mockError).Once()
+			}
+
+			total, err := p.purge(context.Background(), cutoff)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedTotal, total)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package outbox
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"github.com/safayildirim/wallet-management-service/pkg/worker"
+	"time"
+)
+
+// Relay periodically publishes the events recorded in the outbox and marks them as published.
+type Relay struct {
+	outboxRepository Repository
+	publisher        Publisher
+	interval         time.Duration
+	batchSize        int
+}
+
+func NewRelay(outboxRepository Repository, publisher Publisher, conf config.OutboxConfig) (*Relay, error) {
+	if err := worker.Validate(conf.RelayInterval, conf.RelayBatchSize); err != nil {
+		return nil, errors.Wrap(err, "invalid outbox relay config")
+	}
+
+	return &Relay{
+		outboxRepository: outboxRepository,
+		publisher:        publisher,
+		interval:         conf.RelayInterval,
+		batchSize:        conf.RelayBatchSize,
+	}, nil
+}
+
+// Run publishes pending events on every tick until the context is cancelled, then closes
+// the publisher.
+func (r *Relay) Run(ctx context.Context) error {
+	defer r.publisher.Close()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		published, err := r.relay(ctx)
+		if err != nil {
+			logger.Zap.Sugar().Errorf("outbox relay failed: %v", err)
+		} else if published > 0 {
+			logger.Zap.Sugar().Debugf("published %d outbox events", published)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay publishes pending events in batches.
+func (r *Relay) relay(ctx context.Context) (int, error) {
+	var total int
+	for {
+		published, err := r.outboxRepository.PublishEvents(ctx, r.batchSize, r.publisher.Publish)
+		if err != nil {
+			return total, err
+		}
+
+		total += published
+		if published < r.batchSize || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
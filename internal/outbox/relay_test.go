@@ -0,0 +1,88 @@
+package outbox
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/outbox/entity"
+	outboxmock "github.com/safayildirim/wallet-management-service/internal/outbox/mock"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestRelay_Relay(t *testing.T) {
+	event := func(id uint) *entity.Event {
+		return &entity.Event{ID: id, AggregateType: "wallet", AggregateID: "1", Type: "WalletUpdated"}
+	}
+
+	tests := []struct {
+		name          string
+		batches       [][]*entity.Event
+		publishError  error
+		expectedTotal int
+		expectErr     bool
+	}{
+		{
+			name:          "when backlog spans several batches then should keep publishing until a partial batch",
+			batches:       [][]*entity.Event{{event(1), event(2)}, {event(3), event(4)}, {event(5)}},
+			expectedTotal: 5,
+		},
+		{
+			name:          "when nothing is pending then should publish nothing",
+			batches:       [][]*entity.Event{{}},
+			expectedTotal: 0,
+		},
+		{
+			name:          "when publisher returns an error then should stop and return error",
+			batches:       [][]*entity.Event{{event(1), event(2)}, {event(3), event(4)}},
+			publishError:  errors.New("publisher error"),
+			expectedTotal: 2,
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := outboxmock.NewMockOutboxRepository(t)
+			mockPublisher := outboxmock.NewMockOutboxPublisher(t)
+			r, err := NewRelay(mockRepository, mockPublisher, config.OutboxConfig{RelayInterval: time.Second, RelayBatchSize: 2})
+			assert.NoError(t, err)
+
+			for i, batch := range tt.batches {
+				failing := tt.publishError != nil && i == len(tt.batches)-1
+
+				mockRepository.EXPECT().PublishEvents(context.Background(), 2, mock.Anything).
+					RunAndReturn(func(ctx context.Context, limit int, publish func(context.Context, []*entity.Event) error) (int, error) {
+						if len(batch) == 0 {
+							return 0, nil
+						}
+						if err := publish(ctx, batch); err != nil {
+							return 0, err
+						}
+
+						return len(batch), nil
+					}).Once()
+
+				if len(batch) == 0 {
+					continue
+				}
+				if failing {
+					mockPublisher.EXPECT().Publish(context.Background(), batch).Return(tt.publishError).Once()
+				} else {
+					mockPublisher.EXPECT().Publish(context.Background(), batch).Return(nil).Once()
+				}
+			}
+
+			total, err := r.relay(context.Background())
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedTotal, total)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/safayildirim/wallet-management-service/internal/outbox/entity"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"gorm.io/gorm"
+	"time"
+)
+
+type Repository interface {
+	PublishEvents(ctx context.Context, limit int, publish func(ctx context.Context, events []*entity.Event) error) (int, error)
+	PurgeEvents(ctx context.Context, createdBefore time.Time, limit int) (int64, error)
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+// Write records an event in the given transaction, so that the event is stored if and only
+// if the change it describes is committed. The owner of the aggregate is stored alongside the
+// payload, so that the events of an owner can be looked up without reading every payload.
+func Write(tx *gorm.DB, aggregateType string, aggregateID string, ownerID string, eventType string, payload any) error {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(&entity.Event{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		OwnerID:       ownerID,
+		Type:          eventType,
+		Payload:       raw,
+	}).Error
+}
+
+// PublishEvents hands up to limit unpublished events, oldest first, to publish and marks them
+// as published once it returns without error, that is once all of them have been accepted. If
+// publishing fails the events stay unpublished and are handed out again on the next call, so
+// every event is published at least once.
+//
+// Only one caller publishes at a time: were two relays to publish overlapping batches, the
+// events of a wallet could reach the topic out of order. A caller that finds another one
+// publishing returns without publishing anything. The lock is held by the database session
+// rather than a transaction, so that no transaction stays open while the events are published;
+// it is released when the session ends, should the caller die while holding it.
+func (r *repository) PublishEvents(ctx context.Context, limit int, publish func(ctx context.Context, events []*entity.Event) error) (int, error) {
+	var published int
+	err := r.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		var locked bool
+		if err := conn.Raw("SELECT pg_try_advisory_lock(hashtext('outbox_relay'))").Scan(&locked).Error; err != nil {
+			return err
+		}
+
+		if !locked {
+			return nil
+		}
+
+		defer func() {
+			// The session outlives the call, so the lock has to be released even if the context is done.
+			err := conn.WithContext(context.WithoutCancel(ctx)).
+				Exec("SELECT pg_advisory_unlock(hashtext('outbox_relay'))").Error
+			if err != nil {
+				logger.Zap.Sugar().Errorf("releasing outbox relay lock failed: %v", err)
+			}
+		}()
+
+		var events []*entity.Event
+		if err := conn.Where("published_at IS NULL").Order("id").Limit(limit).Find(&events).Error; err != nil {
+			return err
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		if err := publish(ctx, events); err != nil {
+			return err
+		}
+
+		ids := make([]uint, 0, len(events))
+		for _, event := range events {
+			ids = append(ids, event.ID)
+		}
+
+		err := conn.Model(&entity.Event{}).Where("id IN ?", ids).Update("published_at", time.Now()).Error
+		if err != nil {
+			return err
+		}
+
+		published = len(events)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return published, nil
+}
+
+// PurgeEvents permanently removes up to limit events recorded before the given time that have
+// been published, dispatched to webhooks and sequenced into the feed.
+//
+// The event with the last feed position is kept whatever its age: the sequencer continues
+// from it, so removing it would hand its position out again.
+func (r *repository) PurgeEvents(ctx context.Context, createdBefore time.Time, limit int) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(`
+		DELETE FROM outbox WHERE id IN (
+			SELECT id FROM outbox
+			WHERE created_at < ?
+			AND published_at IS NOT NULL
+			AND webhooks_dispatched_at IS NOT NULL
+			AND position < (SELECT max(position) FROM outbox)
+			ORDER BY id
+			LIMIT ?
+		)`,
+		createdBefore, limit,
+	)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,44 @@
+package outbox
+
+import (
+	"github.com/safayildirim/wallet-management-service/internal/outbox/entity"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		SkipDefaultTransaction: true,
+		DisableAutomaticPing:   true,
+	})
+	assert.NoError(t, err)
+
+	var recorded *gorm.Statement
+	err = db.Callback().Create().After("gorm:create").Register("test:record", func(tx *gorm.DB) {
+		recorded = tx.Statement
+	})
+	assert.NoError(t, err)
+
+	// The transaction of the caller, told apart by a setting only it carries.
+	tx := db.Set("caller", "wallet change")
+
+	err = Write(tx, "wallet", "7", "owner-1", "WalletCreated", map[string]any{"id": 7})
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, recorded) {
+		caller, _ := recorded.Settings.Load("caller")
+		assert.Equal(t, "wallet change", caller)
+		assert.Same(t, tx.Statement.ConnPool, recorded.ConnPool)
+		assert.Contains(t, recorded.SQL.String(), `INSERT INTO "outbox"`)
+
+		event := recorded.Dest.(*entity.Event)
+		assert.Equal(t, "wallet", event.AggregateType)
+		assert.Equal(t, "7", event.AggregateID)
+		assert.Equal(t, "owner-1", event.OwnerID)
+		assert.Equal(t, "WalletCreated", event.Type)
+		assert.JSONEq(t, `{"id":7}`, string(event.Payload))
+	}
+}
@@ -0,0 +1,108 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/address"
+	"github.com/safayildirim/wallet-management-service/internal/network"
+	networkentity "github.com/safayildirim/wallet-management-service/internal/network/entity"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"strings"
+)
+
+const (
+	// canonicalAddressesBackfill names the backfill in the backfills table.
+	canonicalAddressesBackfill = "canonical_wallet_addresses"
+	backfillBatchSize          = 500
+)
+
+var ErrAddressCollisions = errors.New("existing wallet addresses collide once canonicalized")
+
+// AddressBackfill canonicalizes the addresses stored before addresses were validated, so that
+// lookups, which canonicalize their input, find them and the unique constraint catches their
+// semantic duplicates. EIP-55 checksums cannot be computed in SQL, hence it is not a migration.
+type AddressBackfill struct {
+	walletRepository Repository
+	networkService   network.Service
+	addressRegistry  *address.Registry
+	batchSize        int
+}
+
+func NewAddressBackfill(walletRepository Repository, networkService network.Service, addressRegistry *address.Registry) *AddressBackfill {
+	return &AddressBackfill{
+		walletRepository: walletRepository,
+		networkService:   networkService,
+		addressRegistry:  addressRegistry,
+		batchSize:        backfillBatchSize,
+	}
+}
+
+// Run rewrites every stored address that is not in canonical form, once. Addresses that are
+// not valid on their network are left as they are and logged. If active addresses collide once
+// canonicalized, the colliding ones are left as they are too and ErrAddressCollisions lists them;
+// the backfill is then not marked completed and is retried once they are resolved.
+func (b *AddressBackfill) Run(ctx context.Context) error {
+	completed, err := b.walletRepository.IsBackfillCompleted(ctx, canonicalAddressesBackfill)
+	if err != nil || completed {
+		return err
+	}
+
+	networks := make(map[string]*networkentity.Network)
+	var rewritten int
+	var collisions []string
+	var afterID uint
+	for {
+		addresses, err := b.walletRepository.ListAddresses(ctx, afterID, b.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, walletAddress := range addresses {
+			net, ok := networks[walletAddress.Network]
+			if !ok {
+				net, err = b.networkService.GetNetwork(ctx, walletAddress.Network)
+				if err != nil {
+					return errors.Wrapf(err, "network %q of wallet address %d", walletAddress.Network, walletAddress.ID)
+				}
+				networks[walletAddress.Network] = net
+			}
+
+			key, err := canonicalKey(b.addressRegistry, net, walletAddress.Address, walletAddress.Memo)
+			if err != nil {
+				logger.Zap.Sugar().Warnf("wallet address %d cannot be canonicalized: %v", walletAddress.ID, err)
+				continue
+			}
+			if key == walletAddress.Key() {
+				continue
+			}
+
+			err = b.walletRepository.UpdateAddressKey(ctx, walletAddress.ID, key)
+			if errors.Is(err, ErrDuplicateAddress) {
+				collisions = append(collisions, fmt.Sprintf(
+					"wallet address %d (wallet %d) as %s/%s", walletAddress.ID, walletAddress.WalletID, key.Network, key.Address,
+				))
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			rewritten++
+		}
+
+		if len(addresses) < b.batchSize {
+			break
+		}
+		afterID = addresses[len(addresses)-1].ID
+	}
+
+	if rewritten > 0 {
+		logger.Zap.Sugar().Infof("canonicalized %d wallet addresses", rewritten)
+	}
+
+	if len(collisions) > 0 {
+		return errors.Wrap(ErrAddressCollisions, strings.Join(collisions, ", "))
+	}
+
+	return b.walletRepository.CompleteBackfill(ctx, canonicalAddressesBackfill)
+}
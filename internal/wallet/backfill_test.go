@@ -0,0 +1,76 @@
+package wallet
+
+import (
+	"context"
+	"github.com/safayildirim/wallet-management-service/internal/address"
+	"github.com/safayildirim/wallet-management-service/internal/wallet/entity"
+	walletmock "github.com/safayildirim/wallet-management-service/internal/wallet/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestAddressBackfill_Run(t *testing.T) {
+	lowercase := entity.WalletAddress{ID: 1, WalletID: 1, Network: "ethereum", Address: "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359"}
+	canonical := entity.WalletAddress{ID: 2, WalletID: 2, Network: "bitcoin", Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}
+	invalid := entity.WalletAddress{ID: 3, WalletID: 3, Network: "bitcoin", Address: "1A2B3C"}
+	checksummed := entity.WalletKey{Network: "ethereum", Address: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"}
+
+	tests := []struct {
+		name          string
+		completed     bool
+		batches       [][]entity.WalletAddress
+		mockUpdate    bool
+		mockUpdateErr error
+		expectedError error
+		expectDone    bool
+	}{
+		{
+			name:      "when backfill has completed then should do nothing",
+			completed: true,
+		},
+		{
+			name:       "when addresses are not canonical then should rewrite them and complete",
+			batches:    [][]entity.WalletAddress{{lowercase, canonical}, {invalid}},
+			mockUpdate: true,
+			expectDone: true,
+		},
+		{
+			name:          "when canonical address collides then should report collision and not complete",
+			batches:       [][]entity.WalletAddress{{lowercase}},
+			mockUpdate:    true,
+			mockUpdateErr: ErrDuplicateAddress,
+			expectedError: ErrAddressCollisions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := walletmock.NewMockWalletRepository(t)
+			b := NewAddressBackfill(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+			b.batchSize = 2
+
+			mockRepository.EXPECT().IsBackfillCompleted(mock.Anything, canonicalAddressesBackfill).Return(tt.completed, nil).Once()
+			var afterID uint
+			for _, batch := range tt.batches {
+				mockRepository.EXPECT().ListAddresses(mock.Anything, afterID, 2).Return(batch, nil).Once()
+				afterID = batch[len(batch)-1].ID
+			}
+			if tt.mockUpdate {
+				mockRepository.EXPECT().UpdateAddressKey(mock.Anything, uint(1), checksummed).Return(tt.mockUpdateErr).Once()
+			}
+			if tt.expectDone {
+				mockRepository.EXPECT().CompleteBackfill(mock.Anything, canonicalAddressesBackfill).Return(nil).Once()
+			}
+
+			err := b.Run(context.Background())
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Contains(t, err.Error(), "wallet address 1 (wallet 1)")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package entity
+
+// AggregateWallet is the aggregate type of the outbox events recorded for wallets.
+const AggregateWallet = "wallet"
+
+// Events recorded in the outbox whenever a wallet changes. The payload of each is the wallet
+// as it is after the change.
+const (
+	EventWalletCreated = "WalletCreated"
+	EventWalletUpdated = "WalletUpdated"
+	EventWalletDeleted = "WalletDeleted"
+)
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+const (
+	SortByID        = "id"
+	SortByCreatedAt = "created_at"
+)
+
+// WalletFilter describes a single page of a wallet listing.
+type WalletFilter struct {
+	OwnerID       string
+	Network       string
+	AddressPrefix string
+	Tags          Tags
+	Metadata      Metadata
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	SortBy        string
+	Descending    bool
+	Limit         int
+	After         *WalletCursor
+}
+
+// WalletCursor holds the sort key of the last wallet on the previous page.
+type WalletCursor struct {
+	CreatedAt time.Time
+	ID        uint
+}
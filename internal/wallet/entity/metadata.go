@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Metadata is a free-form JSON object stored in a jsonb column.
+type Metadata map[string]any
+
+func (m Metadata) Value() (driver.Value, error) {
+	if m == nil {
+		return "{}", nil
+	}
+
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(raw), nil
+}
+
+func (m *Metadata) Scan(value any) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*m = nil
+		return nil
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return errors.Errorf("cannot scan %T into Metadata", value)
+	}
+
+	return json.Unmarshal(raw, m)
+}
@@ -0,0 +1,43 @@
+package entity
+
+import "time"
+
+const (
+	StatusPending = "pending"
+	StatusActive  = "active"
+	StatusFrozen  = "frozen"
+	StatusClosed  = "closed"
+)
+
+// transitions lists the statuses a wallet may move to from each status. A closed
+// wallet is final.
+var transitions = map[string][]string{
+	StatusPending: {StatusActive},
+	StatusActive:  {StatusFrozen, StatusClosed},
+	StatusFrozen:  {StatusActive},
+}
+
+// CanTransition reports whether a wallet may move from one status to another.
+func CanTransition(from, to string) bool {
+	for _, status := range transitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StatusTransition records a change of a wallet's status and the reason given for it.
+type StatusTransition struct {
+	ID         uint      `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	WalletID   uint      `json:"wallet_id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	Reason     string    `json:"reason"`
+}
+
+func (StatusTransition) TableName() string {
+	return "wallet_status_transitions"
+}
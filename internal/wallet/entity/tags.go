@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"database/sql/driver"
+
+	"github.com/lib/pq"
+)
+
+// Tags is a set of lowercase labels stored in a text[] column.
+type Tags []string
+
+func (t Tags) Value() (driver.Value, error) {
+	if t == nil {
+		return "{}", nil
+	}
+
+	return pq.StringArray(t).Value()
+}
+
+func (t *Tags) Scan(value any) error {
+	var array pq.StringArray
+	if err := array.Scan(value); err != nil {
+		return err
+	}
+
+	*t = Tags(array)
+	return nil
+}
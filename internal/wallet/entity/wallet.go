@@ -1,15 +1,61 @@
 package entity
 
 import (
+	"gopkg.in/guregu/null.v3"
+	"gorm.io/gorm"
 	"time"
 )
-import "gopkg.in/guregu/null.v3"
 
+// Wallet is a customer's logical wallet; the addresses it holds on the various
+// networks are its children.
 type Wallet struct {
-	ID        uint      `json:"id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt null.Time `json:"updated_at"`
-	DeletedAt null.Time `json:"deleted_at"`
-	Address   string    `json:"address"`
-	Network   string    `json:"network"`
+	ID        uint            `json:"id"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt null.Time       `json:"updated_at"`
+	DeletedAt gorm.DeletedAt  `json:"deleted_at"`
+	OwnerID   string          `json:"owner_id"`
+	Label     string          `json:"label"`
+	Tags      Tags            `json:"tags" gorm:"type:text[]"`
+	Metadata  Metadata        `json:"metadata"`
+	Status    string          `json:"status" gorm:"default:active"`
+	Version   uint            `json:"version" gorm:"default:1"`
+	Addresses []WalletAddress `json:"addresses" gorm:"foreignKey:WalletID"`
+}
+
+// WalletAddress is an address of a wallet on a single network. An address is
+// soft-deleted together with its wallet and removed for good when detached.
+type WalletAddress struct {
+	ID        uint           `json:"id"`
+	CreatedAt time.Time      `json:"created_at"`
+	DeletedAt gorm.DeletedAt `json:"-"`
+	WalletID  uint           `json:"wallet_id"`
+	Network   string         `json:"network"`
+	Address   string         `json:"address"`
+	Memo      string         `json:"memo"`
+}
+
+// Key returns the natural key of the address.
+func (a WalletAddress) Key() WalletKey {
+	return WalletKey{Network: a.Network, Address: a.Address, Memo: a.Memo}
+}
+
+// WalletKey is the natural key of a wallet address. Memo is empty unless the address
+// is shared and the wallet is told apart by a memo or destination tag.
+type WalletKey struct {
+	Network string `json:"network"`
+	Address string `json:"address"`
+	Memo    string `json:"memo,omitempty"`
+}
+
+// WalletChanges holds the mutable fields of a wallet; nil fields are left untouched.
+type WalletChanges struct {
+	Label    *string
+	Tags     *Tags
+	Metadata *Metadata
+}
+
+// WalletState is the part of a wallet that decides who may use it and how.
+type WalletState struct {
+	OwnerID string
+	Status  string
 }
@@ -1,7 +1,22 @@
 package wallet
 
-import "github.com/pkg/errors"
+import "github.com/safayildirim/wallet-management-service/internal/apperror"
 
 var (
-	ErrDuplicateWallet = errors.New("wallet already exists")
+	ErrDuplicateAddress = apperror.AlreadyExists("wallet_address_already_exists", "address is already registered to a wallet")
+	ErrAddressNotFound  = apperror.NotFound("wallet_address_not_found", "wallet address not found")
+	ErrWalletNotFound   = apperror.NotFound("wallet_not_found", "wallet not found")
+	ErrWalletNotDeleted = apperror.Conflict("wallet_not_deleted", "wallet is not deleted")
+	ErrInvalidCursor    = apperror.Validation("invalid_cursor", "invalid cursor")
+	ErrVersionMismatch  = apperror.PreconditionFailed("wallet_version_mismatch", "wallet has been modified by another request")
+	ErrIfMatchRequired  = apperror.PreconditionRequired("if_match_required", "If-Match header is required")
+	ErrUnknownNetwork   = apperror.Validation("unknown_network", "unknown network")
+	ErrNetworkDisabled  = apperror.Validation("network_disabled", "network is disabled")
+	ErrOwnerForbidden   = apperror.Forbidden("owner_forbidden", "not authorized for this owner")
+	ErrWalletFrozen     = apperror.Conflict("wallet_frozen", "wallet is frozen")
+	ErrWalletClosed     = apperror.Conflict("wallet_closed", "wallet is closed")
+	ErrWalletNotActive  = apperror.Conflict("wallet_not_active", "wallet is not active")
+	ErrStatusChanged    = apperror.Conflict("wallet_status_changed", "wallet status has been changed by another request")
+
+	ErrInvalidStatusTransition = apperror.Conflict("invalid_status_transition", "wallet status transition is not allowed")
 )
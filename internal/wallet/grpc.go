@@ -0,0 +1,223 @@
+package wallet
+
+import (
+	"context"
+	walletv1 "github.com/safayildirim/wallet-management-service/api/wallet/v1"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/wallet/entity"
+	"github.com/safayildirim/wallet-management-service/internal/wallet/request"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// GRPCHandler serves the wallet service over gRPC. Errors are returned as they are and mapped to
+// gRPC statuses by apperror.UnaryServerInterceptor.
+type GRPCHandler struct {
+	walletv1.UnimplementedWalletServiceServer
+	walletService Service
+}
+
+func NewGRPCHandler(walletService Service) *GRPCHandler {
+	return &GRPCHandler{walletService: walletService}
+}
+
+func (h *GRPCHandler) Register(s grpc.ServiceRegistrar) {
+	walletv1.RegisterWalletServiceServer(s, h)
+}
+
+// CreateWallet creates a wallet, optionally with its addresses.
+//
+// Parameters:
+//   - ctx: Context carrying the scope of the caller.
+//   - in: The wallet to create.
+//
+// Returns:
+//   - The created wallet on success.
+//   - InvalidArgument if the request is invalid or a network is unknown or disabled.
+//   - PermissionDenied if the caller is not authorized for the owner.
+//   - AlreadyExists if an address is already registered to a wallet.
+//   - Internal for unexpected issues.
+func (h *GRPCHandler) CreateWallet(ctx context.Context, in *walletv1.CreateWalletRequest) (*walletv1.CreateWalletResponse, error) {
+	req := request.CreateWalletRequest{
+		OwnerID:   in.GetOwnerId(),
+		Label:     in.GetLabel(),
+		Status:    in.GetStatus(),
+		Tags:      in.GetTags(),
+		Addresses: make([]request.WalletAddressRequest, 0, len(in.GetAddresses())),
+	}
+	if in.GetMetadata() != nil {
+		req.Metadata = in.GetMetadata().AsMap()
+	}
+	for _, address := range in.GetAddresses() {
+		req.Addresses = append(req.Addresses, request.WalletAddressRequest{
+			Network: address.GetNetwork(),
+			Address: address.GetAddress(),
+			Memo:    address.GetMemo(),
+		})
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, apperror.InvalidRequest(err)
+	}
+
+	wallet, err := h.walletService.CreateWallet(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := toProtoWallet(wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &walletv1.CreateWalletResponse{Wallet: out}, nil
+}
+
+// GetWallet retrieves a wallet by its unique ID.
+//
+// Parameters:
+//   - ctx: Context carrying the scope of the caller.
+//   - in: The ID of the wallet.
+//
+// Returns:
+//   - The wallet on success.
+//   - NotFound if the wallet does not exist.
+//   - Internal for unexpected issues.
+func (h *GRPCHandler) GetWallet(ctx context.Context, in *walletv1.GetWalletRequest) (*walletv1.GetWalletResponse, error) {
+	wallet, err := h.walletService.GetWallet(ctx, uint(in.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := toProtoWallet(wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &walletv1.GetWalletResponse{Wallet: out}, nil
+}
+
+// DeleteWallet deletes a wallet by its unique ID.
+//
+// Parameters:
+//   - ctx: Context carrying the scope of the caller.
+//   - in: The ID of the wallet.
+//
+// Returns:
+//   - An empty response on success.
+//   - NotFound if the wallet does not exist.
+//   - FailedPrecondition if the wallet is frozen or closed.
+//   - Internal for unexpected issues.
+func (h *GRPCHandler) DeleteWallet(ctx context.Context, in *walletv1.DeleteWalletRequest) (*walletv1.DeleteWalletResponse, error) {
+	if err := h.walletService.DeleteWallet(ctx, uint(in.GetId())); err != nil {
+		return nil, err
+	}
+
+	return &walletv1.DeleteWalletResponse{}, nil
+}
+
+// ListWallets retrieves a page of wallets matching the filters.
+//
+// Parameters:
+//   - ctx: Context carrying the scope of the caller.
+//   - in: The filters, the page size and the page token.
+//
+// Returns:
+//   - The wallets and the token of the next page on success.
+//   - InvalidArgument if the filters or the page token are invalid.
+//   - PermissionDenied if the caller is not authorized for the owner.
+//   - Internal for unexpected issues.
+func (h *GRPCHandler) ListWallets(ctx context.Context, in *walletv1.ListWalletsRequest) (*walletv1.ListWalletsResponse, error) {
+	req := request.ListWalletsRequest{
+		OwnerID:       in.GetOwnerId(),
+		Network:       in.GetNetwork(),
+		AddressPrefix: in.GetAddressPrefix(),
+		Tags:          in.GetTags(),
+		Metadata:      in.GetMetadata(),
+		SortBy:        in.GetSortBy(),
+		Order:         in.GetOrder(),
+		Limit:         int(in.GetPageSize()),
+		Cursor:        in.GetPageToken(),
+	}
+
+	var err error
+	if req.CreatedFrom, err = fromProtoTime(in.GetCreatedFrom()); err != nil {
+		return nil, apperror.InvalidParam("created_from", err)
+	}
+	if req.CreatedTo, err = fromProtoTime(in.GetCreatedTo()); err != nil {
+		return nil, apperror.InvalidParam("created_to", err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, apperror.InvalidRequest(err)
+	}
+
+	wallets, next, err := h.walletService.ListWallets(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &walletv1.ListWalletsResponse{
+		Wallets:       make([]*walletv1.Wallet, 0, len(wallets)),
+		NextPageToken: next,
+	}
+	for _, wallet := range wallets {
+		item, err := toProtoWallet(wallet)
+		if err != nil {
+			return nil, err
+		}
+		out.Wallets = append(out.Wallets, item)
+	}
+
+	return out, nil
+}
+
+// toProtoWallet converts a wallet into its protobuf message.
+func toProtoWallet(wallet *entity.Wallet) (*walletv1.Wallet, error) {
+	metadata, err := structpb.NewStruct(wallet.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &walletv1.Wallet{
+		Id:        uint64(wallet.ID),
+		CreatedAt: timestamppb.New(wallet.CreatedAt),
+		OwnerId:   wallet.OwnerID,
+		Label:     wallet.Label,
+		Tags:      wallet.Tags,
+		Metadata:  metadata,
+		Status:    wallet.Status,
+		Version:   uint64(wallet.Version),
+		Addresses: make([]*walletv1.WalletAddress, 0, len(wallet.Addresses)),
+	}
+	if wallet.UpdatedAt.Valid {
+		out.UpdatedAt = timestamppb.New(wallet.UpdatedAt.Time)
+	}
+	for _, address := range wallet.Addresses {
+		out.Addresses = append(out.Addresses, &walletv1.WalletAddress{
+			Id:        uint64(address.ID),
+			CreatedAt: timestamppb.New(address.CreatedAt),
+			Network:   address.Network,
+			Address:   address.Address,
+			Memo:      address.Memo,
+		})
+	}
+
+	return out, nil
+}
+
+// fromProtoTime converts an optional timestamp, returning nil if it is not set.
+func fromProtoTime(ts *timestamppb.Timestamp) (*time.Time, error) {
+	if ts == nil {
+		return nil, nil
+	}
+
+	if err := ts.CheckValid(); err != nil {
+		return nil, err
+	}
+
+	t := ts.AsTime()
+	return &t, nil
+}
@@ -0,0 +1,274 @@
+package wallet
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	walletv1 "github.com/safayildirim/wallet-management-service/api/wallet/v1"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/wallet/entity"
+	walletmock "github.com/safayildirim/wallet-management-service/internal/wallet/mock"
+	"github.com/safayildirim/wallet-management-service/internal/wallet/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/guregu/null.v3"
+	"testing"
+	"time"
+)
+
+func TestGRPCHandler_CreateWallet(t *testing.T) {
+	metadata, _ := structpb.NewStruct(map[string]any{"tier": "gold"})
+
+	tests := []struct {
+		name                 string
+		request              *walletv1.CreateWalletRequest
+		mockService          bool
+		mockReturn           *entity.Wallet
+		mockError            error
+		expectedCode         codes.Code
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name: "when valid request is provided then should create wallet",
+			request: &walletv1.CreateWalletRequest{
+				OwnerId:   "owner1",
+				Label:     "main",
+				Metadata:  metadata,
+				Addresses: []*walletv1.AddressInput{{Network: "network1", Address: "address1"}},
+			},
+			mockService: true,
+			mockReturn: &entity.Wallet{
+				ID:        1,
+				OwnerID:   "owner1",
+				Label:     "main",
+				Metadata:  entity.Metadata{"tier": "gold"},
+				Addresses: []entity.WalletAddress{{ID: 1, WalletID: 1, Address: "address1", Network: "network1"}},
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:                 "when owner is missing then should return invalid argument",
+			request:              &walletv1.CreateWalletRequest{Label: "main"},
+			expectedCode:         codes.InvalidArgument,
+			expectErr:            true,
+			expectedErrorMessage: "owner_id: cannot be blank",
+		},
+		{
+			name: "when address is already registered then should return already exists",
+			request: &walletv1.CreateWalletRequest{
+				OwnerId:   "owner1",
+				Addresses: []*walletv1.AddressInput{{Network: "network1", Address: "address1"}},
+			},
+			mockService:          true,
+			mockError:            ErrDuplicateAddress,
+			expectedCode:         codes.AlreadyExists,
+			expectErr:            true,
+			expectedErrorMessage: "address is already registered",
+		},
+		{
+			name:                 "when service returns error then should return internal",
+			request:              &walletv1.CreateWalletRequest{OwnerId: "owner1"},
+			mockService:          true,
+			mockError:            errors.New("internal server error"),
+			expectedCode:         codes.Internal,
+			expectErr:            true,
+			expectedErrorMessage: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := walletmock.NewMockWalletService(t)
+			handler := NewGRPCHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().CreateWallet(mock.Anything, mock.Anything).
+					Return(tt.mockReturn, tt.mockError).Once()
+			}
+
+			resp, err := handler.CreateWallet(context.Background(), tt.request)
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedCode, apperror.CodeOf(err))
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(1), resp.GetWallet().GetId())
+				assert.Equal(t, "gold", resp.GetWallet().GetMetadata().AsMap()["tier"])
+				assert.Len(t, resp.GetWallet().GetAddresses(), 1)
+			}
+		})
+	}
+}
+
+func TestGRPCHandler_GetWallet(t *testing.T) {
+	updatedAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		mockReturn        *entity.Wallet
+		mockError         error
+		expectedCode      codes.Code
+		expectErr         bool
+		expectedUpdatedAt *timestamppb.Timestamp
+	}{
+		{
+			name:         "when wallet exists then should return wallet",
+			mockReturn:   &entity.Wallet{ID: 1, OwnerID: "owner1"},
+			expectedCode: codes.OK,
+		},
+		{
+			name:              "when wallet was updated then should return update time",
+			mockReturn:        &entity.Wallet{ID: 1, OwnerID: "owner1", UpdatedAt: null.TimeFrom(updatedAt)},
+			expectedCode:      codes.OK,
+			expectedUpdatedAt: timestamppb.New(updatedAt),
+		},
+		{
+			name:         "when wallet not found then should return not found",
+			mockError:    ErrWalletNotFound,
+			expectedCode: codes.NotFound,
+			expectErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := walletmock.NewMockWalletService(t)
+			handler := NewGRPCHandler(mockService)
+
+			mockService.EXPECT().GetWallet(mock.Anything, uint(1)).
+				Return(tt.mockReturn, tt.mockError).Once()
+
+			resp, err := handler.GetWallet(context.Background(), &walletv1.GetWalletRequest{Id: 1})
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedCode, apperror.CodeOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "owner1", resp.GetWallet().GetOwnerId())
+				assert.Equal(t, tt.expectedUpdatedAt.AsTime(), resp.GetWallet().GetUpdatedAt().AsTime())
+			}
+		})
+	}
+}
+
+func TestGRPCHandler_DeleteWallet(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockError    error
+		expectedCode codes.Code
+		expectErr    bool
+	}{
+		{
+			name:         "when wallet exists then should delete wallet",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "when wallet not found then should return not found",
+			mockError:    ErrWalletNotFound,
+			expectedCode: codes.NotFound,
+			expectErr:    true,
+		},
+		{
+			name:         "when wallet is frozen then should return failed precondition",
+			mockError:    ErrWalletFrozen,
+			expectedCode: codes.FailedPrecondition,
+			expectErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := walletmock.NewMockWalletService(t)
+			handler := NewGRPCHandler(mockService)
+
+			mockService.EXPECT().DeleteWallet(mock.Anything, uint(1)).Return(tt.mockError).Once()
+
+			_, err := handler.DeleteWallet(context.Background(), &walletv1.DeleteWalletRequest{Id: 1})
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedCode, apperror.CodeOf(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGRPCHandler_ListWallets(t *testing.T) {
+	createdFrom := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                 string
+		request              *walletv1.ListWalletsRequest
+		mockService          bool
+		expectedRequest      *request.ListWalletsRequest
+		mockReturn           []*entity.Wallet
+		mockNext             string
+		expectedCode         codes.Code
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name: "when filters are provided then should list wallets",
+			request: &walletv1.ListWalletsRequest{
+				OwnerId:     "owner1",
+				Tags:        []string{"hot"},
+				CreatedFrom: timestamppb.New(createdFrom),
+				PageSize:    10,
+				PageToken:   "token",
+			},
+			mockService: true,
+			expectedRequest: &request.ListWalletsRequest{
+				OwnerID:     "owner1",
+				Tags:        request.Tags{"hot"},
+				CreatedFrom: &createdFrom,
+				Limit:       10,
+				Cursor:      "token",
+			},
+			mockReturn:   []*entity.Wallet{{ID: 1}, {ID: 2}},
+			mockNext:     "next",
+			expectedCode: codes.OK,
+		},
+		{
+			name:                 "when page size is too large then should return invalid argument",
+			request:              &walletv1.ListWalletsRequest{PageSize: 100000},
+			expectedCode:         codes.InvalidArgument,
+			expectErr:            true,
+			expectedErrorMessage: "limit",
+		},
+		{
+			name:                 "when timestamp is invalid then should return invalid argument",
+			request:              &walletv1.ListWalletsRequest{CreatedTo: &timestamppb.Timestamp{Nanos: -1}},
+			expectedCode:         codes.InvalidArgument,
+			expectErr:            true,
+			expectedErrorMessage: "out-of-range nanos",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := walletmock.NewMockWalletService(t)
+			handler := NewGRPCHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().ListWallets(mock.Anything, tt.expectedRequest).
+					Return(tt.mockReturn, tt.mockNext, nil).Once()
+			}
+
+			resp, err := handler.ListWallets(context.Background(), tt.request)
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedCode, apperror.CodeOf(err))
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, resp.GetWallets(), len(tt.mockReturn))
+				assert.Equal(t, tt.mockNext, resp.GetNextPageToken())
+			}
+		})
+	}
+}
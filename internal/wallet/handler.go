@@ -1,10 +1,10 @@
 package wallet
 
 import (
-	"fmt"
 	"github.com/labstack/echo/v4"
-	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
 	"github.com/safayildirim/wallet-management-service/internal/common"
+	"github.com/safayildirim/wallet-management-service/internal/wallet/entity"
 	"github.com/safayildirim/wallet-management-service/internal/wallet/request"
 	"net/http"
 )
@@ -19,39 +19,46 @@ func NewHandler(walletService Service) *Handler {
 
 func (h Handler) RegisterRoutes(e *echo.Group) {
 	e.POST("/wallets", h.CreateWallet)
+	e.GET("/wallets", h.ListWallets)
+	e.GET("/wallets/by-address", h.GetWalletByAddress)
+	e.POST("/wallets/by-address/batch", h.GetWalletsByAddresses)
 	e.GET("/wallets/:id", h.GetWallet)
+	e.PATCH("/wallets/:id", h.UpdateWallet)
 	e.DELETE("/wallets/:id", h.DeleteWallet)
+	e.POST("/wallets/:id/restore", h.RestoreWallet)
+	e.POST("/wallets/:id/addresses", h.AttachAddress)
+	e.DELETE("/wallets/:id/addresses/:address_id", h.DetachAddress)
+	e.POST("/wallets/:id/activate", h.ActivateWallet)
+	e.POST("/wallets/:id/freeze", h.FreezeWallet)
+	e.POST("/wallets/:id/close", h.CloseWallet)
+	e.GET("/wallets/:id/status-transitions", h.ListStatusTransitions)
+	e.GET("/owners/:owner_id/wallets", h.ListOwnerWallets)
 }
 
-// CreateWallet handles the creation of a new wallet.
+// CreateWallet handles the creation of a new wallet and its addresses.
 //
 // Parameters:
 //   - ctx: The Echo context containing the HTTP request and response.
 //
 // Returns:
-//   - 200 OK with the created wallet on success.
-//   - 400 Bad Request if the request payload is invalid.
-//   - 409 Conflict if a duplicate wallet is detected.
+//   - 201 Created with the created wallet on success.
+//   - 400 Bad Request if the request payload is invalid, a network is unknown or disabled
+//     or an address or memo is not valid on its network.
+//   - 409 Conflict if an address is already registered to a wallet.
 //   - 500 Internal Server Error for unexpected issues.
 func (h Handler) CreateWallet(ctx echo.Context) error {
 	var req request.CreateWalletRequest
 	if err := ctx.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return apperror.InvalidRequest(err)
 	}
 
 	if err := req.Validate(); err != nil {
-		fmt.Println(err)
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return apperror.InvalidRequest(err)
 	}
 
 	wallet, err := h.walletService.CreateWallet(ctx.Request().Context(), &req)
 	if err != nil {
-		switch {
-		case errors.Is(err, ErrDuplicateWallet):
-			return echo.NewHTTPError(http.StatusConflict, err.Error())
-		}
-
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return ctx.JSON(http.StatusCreated, Response{Data: wallet})
@@ -63,23 +70,188 @@ func (h Handler) CreateWallet(ctx echo.Context) error {
 //   - ctx: The Echo context containing the HTTP request and response.
 //
 // Returns:
-//   - 200 OK with the wallet on success.
+//   - 200 OK with the wallet and its ETag on success.
 //   - 400 Bad Request if the ID is invalid.
+//   - 404 Not Found if the wallet does not exist.
 //   - 500 Internal Server Error for unexpected issues.
 func (h Handler) GetWallet(ctx echo.Context) error {
 	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return apperror.InvalidParam("id", err)
 	}
 
 	wallet, err := h.walletService.GetWallet(ctx.Request().Context(), id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return err
 	}
 
+	ctx.Response().Header().Set(HeaderETag, etag(wallet))
+
 	return ctx.JSON(http.StatusOK, wallet)
 }
 
+// GetWalletByAddress retrieves the wallet holding an address on a network.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the wallet on success.
+//   - 400 Bad Request if the network is unknown, the address is missing or the address or memo is invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) GetWalletByAddress(ctx echo.Context) error {
+	var req request.GetWalletByAddressRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	wallet, err := h.walletService.GetWalletByAddress(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, wallet)
+}
+
+// GetWalletsByAddresses retrieves the wallets holding any of many addresses at once.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the wallets found and the keys that did not match any wallet.
+//   - 400 Bad Request if the request payload is invalid.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) GetWalletsByAddresses(ctx echo.Context) error {
+	var req request.GetWalletsByAddressesRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	wallets, notFound, err := h.walletService.GetWalletsByAddresses(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: BatchLookupResult{Wallets: wallets, NotFound: notFound}})
+}
+
+// ListWallets retrieves a page of wallets matching the query filters.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the wallets and the cursor of the next page on success.
+//   - 400 Bad Request if the query parameters or the cursor are invalid.
+//   - 403 Forbidden if the caller is not authorized for the requested owner.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) ListWallets(ctx echo.Context) error {
+	var req request.ListWalletsRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	wallets, next, err := h.walletService.ListWallets(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: wallets, NextCursor: next})
+}
+
+// ListOwnerWallets retrieves a page of the wallets of a single owner matching the query filters.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the wallets and the cursor of the next page on success.
+//   - 400 Bad Request if the query parameters or the cursor are invalid.
+//   - 403 Forbidden if the caller is not authorized for the owner.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) ListOwnerWallets(ctx echo.Context) error {
+	var req request.ListWalletsRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	// The owner in the path takes precedence over the query
+	req.OwnerID = ctx.Param("owner_id")
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	wallets, next, err := h.walletService.ListWallets(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: wallets, NextCursor: next})
+}
+
+// UpdateWallet partially updates a wallet. The request must carry the wallet's
+// ETag in the If-Match header so that concurrent edits are detected.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the updated wallet and its new ETag on success.
+//   - 400 Bad Request if the ID or the request payload is invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 409 Conflict if the wallet is frozen or closed.
+//   - 412 Precondition Failed if the wallet was modified since the ETag was issued.
+//   - 428 Precondition Required if the If-Match header is missing.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) UpdateWallet(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	ifMatch := ctx.Request().Header.Get(HeaderIfMatch)
+	if ifMatch == "" {
+		return ErrIfMatchRequired
+	}
+
+	version, ok := parseIfMatch(ifMatch)
+	if !ok {
+		return ErrVersionMismatch
+	}
+
+	var req request.UpdateWalletRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	wallet, err := h.walletService.UpdateWallet(ctx.Request().Context(), id, version, &req)
+	if err != nil {
+		return err
+	}
+
+	ctx.Response().Header().Set(HeaderETag, etag(wallet))
+
+	return ctx.JSON(http.StatusOK, Response{Data: wallet})
+}
+
 // DeleteWallet deletes a wallet by its unique ID.
 //
 // Parameters:
@@ -88,17 +260,206 @@ func (h Handler) GetWallet(ctx echo.Context) error {
 // Returns:
 //   - 204 No Content on success.
 //   - 400 Bad Request if the ID is invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 409 Conflict if the wallet is frozen or closed.
 //   - 500 Internal Server Error for unexpected issues.
 func (h Handler) DeleteWallet(ctx echo.Context) error {
 	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return apperror.InvalidParam("id", err)
 	}
 
 	err = h.walletService.DeleteWallet(ctx.Request().Context(), id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	return ctx.NoContent(http.StatusNoContent)
 }
+
+// RestoreWallet restores a soft-deleted wallet by its unique ID.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the restored wallet on success.
+//   - 400 Bad Request if the ID is invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 409 Conflict if the wallet is not deleted or one of its addresses is in use by another wallet.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) RestoreWallet(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	wallet, err := h.walletService.RestoreWallet(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	ctx.Response().Header().Set(HeaderETag, etag(wallet))
+
+	return ctx.JSON(http.StatusOK, Response{Data: wallet})
+}
+
+// AttachAddress adds an address on a network to a wallet.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 201 Created with the attached address on success.
+//   - 400 Bad Request if the ID or the request payload is invalid, the network is unknown or
+//     disabled or the address or memo is not valid on the network.
+//   - 404 Not Found if the wallet does not exist.
+//   - 409 Conflict if the address is already registered to a wallet or the wallet is frozen or closed.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) AttachAddress(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	var req request.WalletAddressRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	address, err := h.walletService.AttachAddress(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, Response{Data: address})
+}
+
+// DetachAddress removes an address from a wallet.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 204 No Content on success.
+//   - 400 Bad Request if the wallet or address ID is invalid.
+//   - 404 Not Found if the wallet or the address does not exist.
+//   - 409 Conflict if the wallet is frozen or closed.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) DetachAddress(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	addressID, err := common.ParseIntFromString[uint](ctx.Param("address_id"))
+	if err != nil {
+		return apperror.InvalidParam("address_id", err)
+	}
+
+	err = h.walletService.DetachAddress(ctx.Request().Context(), id, addressID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ActivateWallet activates a pending wallet or unfreezes a frozen one.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the activated wallet and its new ETag on success.
+//   - 400 Bad Request if the ID or the request payload is invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 409 Conflict if the wallet cannot be activated from its status or its status changed concurrently.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) ActivateWallet(ctx echo.Context) error {
+	return h.transitionWallet(ctx, entity.StatusActive)
+}
+
+// FreezeWallet freezes an active wallet, blocking every change to it until it is activated again.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the frozen wallet and its new ETag on success.
+//   - 400 Bad Request if the ID or the request payload is invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 409 Conflict if the wallet is not active or its status changed concurrently.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) FreezeWallet(ctx echo.Context) error {
+	return h.transitionWallet(ctx, entity.StatusFrozen)
+}
+
+// CloseWallet closes an active wallet for good.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the closed wallet and its new ETag on success.
+//   - 400 Bad Request if the ID or the request payload is invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 409 Conflict if the wallet is not active or its status changed concurrently.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) CloseWallet(ctx echo.Context) error {
+	return h.transitionWallet(ctx, entity.StatusClosed)
+}
+
+// ListStatusTransitions retrieves the status history of a wallet.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the status transitions of the wallet, oldest first.
+//   - 400 Bad Request if the ID is invalid.
+//   - 404 Not Found if the wallet does not exist.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) ListStatusTransitions(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	transitions, err := h.walletService.ListStatusTransitions(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: transitions})
+}
+
+// transitionWallet moves the wallet in the path to the given status.
+func (h Handler) transitionWallet(ctx echo.Context, status string) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	var req request.TransitionWalletRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	wallet, err := h.walletService.TransitionWallet(ctx.Request().Context(), id, status, &req)
+	if err != nil {
+		return err
+	}
+
+	ctx.Response().Header().Set(HeaderETag, etag(wallet))
+
+	return ctx.JSON(http.StatusOK, Response{Data: wallet})
+}
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
 	"github.com/safayildirim/wallet-management-service/internal/wallet/entity"
 	walletmock "github.com/safayildirim/wallet-management-service/internal/wallet/mock"
+	"github.com/safayildirim/wallet-management-service/internal/wallet/request"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gopkg.in/guregu/null.v3"
+	"gorm.io/gorm"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -34,18 +37,20 @@ func TestHandler_GetWallet(t *testing.T) {
 			walletID:    "1",
 			mockService: true,
 			mockReturnData: &entity.Wallet{
-				ID: 1, Address: "address1", Network: "network1",
+				ID: 1, Addresses: []entity.WalletAddress{{ID: 1, WalletID: 1, Address: "address1", Network: "network1"}},
 			},
 			mockReturnErr:  nil,
 			expectedStatus: http.StatusOK,
 		},
 		{
-			name:           "when wallet not found then should return not found",
-			walletID:       "1",
-			mockService:    true,
-			mockReturnData: &entity.Wallet{},
-			mockReturnErr:  nil,
-			expectedStatus: http.StatusNotFound,
+			name:                 "when wallet not found then should return not found",
+			walletID:             "1",
+			mockService:          true,
+			mockReturnData:       nil,
+			mockReturnErr:        ErrWalletNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "wallet not found",
 		},
 		{
 			name:                 "when invalid wallet id is provided then should return bad request",
@@ -89,8 +94,8 @@ func TestHandler_GetWallet(t *testing.T) {
 			err := handler.GetWallet(ctx)
 			if tt.expectErr {
 				assert.Error(t, err)
-				httpErr := err.(*echo.HTTPError)
-				assert.Contains(t, httpErr.Message, tt.expectedErrorMessage)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
 			} else {
 				assert.NoError(t, err)
 
@@ -114,22 +119,22 @@ func TestHandler_CreateWallet(t *testing.T) {
 	}{
 		{
 			name:        "when valid request body is provided then should create wallet",
-			body:        `{"address":"address1","network":"network1"}`,
+			body:        `{"owner_id":"owner1","label":"main","addresses":[{"address":"address1","network":"network1"}]}`,
 			mockService: true,
 			mockReturn: &entity.Wallet{
 				ID:        1,
 				CreatedAt: time.Time{},
 				UpdatedAt: null.Time{},
-				DeletedAt: null.Time{},
-				Address:   "address1",
-				Network:   "network1",
+				DeletedAt: gorm.DeletedAt{},
+				Label:     "main",
+				Addresses: []entity.WalletAddress{{ID: 1, WalletID: 1, Address: "address1", Network: "network1"}},
 			},
 			mockError:      nil,
 			expectedStatus: http.StatusCreated,
 		},
 		{
 			name:                 "when invalid request body is provided then should return bad request",
-			body:                 `{"address":123}`,
+			body:                 `{"addresses":[{"address":123}]}`,
 			mockReturn:           nil,
 			mockError:            nil,
 			expectedStatus:       http.StatusBadRequest,
@@ -138,7 +143,7 @@ func TestHandler_CreateWallet(t *testing.T) {
 		},
 		{
 			name:                 "when empty request body is provided then should return bad request",
-			body:                 `{"address":""}`,
+			body:                 `{"owner_id":"owner1","addresses":[{"address":""}]}`,
 			mockReturn:           nil,
 			mockError:            nil,
 			expectedStatus:       http.StatusBadRequest,
@@ -146,18 +151,34 @@ func TestHandler_CreateWallet(t *testing.T) {
 			expectedErrorMessage: "address: cannot be blank",
 		},
 		{
-			name:                 "when wallet already exists then should return conflict",
-			body:                 `{"address":"address1","network":"network1"}`,
+			name:                 "when tag is invalid then should return bad request",
+			body:                 `{"owner_id":"owner1","tags":["hot","not a tag"]}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "tags: (1: must be in a valid format.)",
+		},
+		{
+			name:                 "when owner is missing then should return bad request",
+			body:                 `{"label":"main"}`,
+			mockReturn:           nil,
+			mockError:            nil,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "owner_id: cannot be blank",
+		},
+		{
+			name:                 "when address is already registered then should return conflict",
+			body:                 `{"owner_id":"owner1","addresses":[{"address":"address1","network":"network1"}]}`,
 			mockService:          true,
 			mockReturn:           nil,
-			mockError:            ErrDuplicateWallet,
+			mockError:            ErrDuplicateAddress,
 			expectedStatus:       http.StatusConflict,
 			expectErr:            true,
-			expectedErrorMessage: "wallet already exist",
+			expectedErrorMessage: "address is already registered",
 		},
 		{
 			name:                 "when service returns error then should return internal server error",
-			body:                 `{"address":"address1","network":"network1"}`,
+			body:                 `{"owner_id":"owner1","addresses":[{"address":"address1","network":"network1"}]}`,
 			mockService:          true,
 			mockReturn:           nil,
 			mockError:            errors.New("internal server error"),
@@ -187,6 +208,7 @@ func TestHandler_CreateWallet(t *testing.T) {
 			if tt.expectErr {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -207,11 +229,20 @@ func TestHandler_DeleteWallet(t *testing.T) {
 		expectedErrorMessage string
 	}{
 		{
-			name:           "when valid request body is provided then should deposit asset",
+			name:           "when valid wallet id is provided then should delete wallet",
 			walletID:       "1",
 			mockService:    true,
 			mockReturnErr:  nil,
-			expectedStatus: http.StatusOK,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:                 "when wallet not found then should return not found",
+			walletID:             "1",
+			mockService:          true,
+			mockReturnErr:        ErrWalletNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "wallet not found",
 		},
 		{
 			name:                 "when invalid wallet id is provided then should return bad request",
@@ -254,8 +285,811 @@ func TestHandler_DeleteWallet(t *testing.T) {
 			if tt.expectErr {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandler_ListWallets(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		query                string
+		mockService          bool
+		mockReturnData       []*entity.Wallet
+		mockReturnCursor     string
+		mockReturnErr        error
+		expectedStatus       int
+		expectedBody         string
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:        "when valid query is provided then should return wallets with next cursor",
+			query:       "?network=network1&sort_by=created_at&order=desc&limit=1",
+			mockService: true,
+			mockReturnData: []*entity.Wallet{
+				{ID: 1, Addresses: []entity.WalletAddress{{ID: 1, WalletID: 1, Address: "address1", Network: "network1"}}},
+			},
+			mockReturnCursor: "next",
+			expectedStatus:   http.StatusOK,
+			expectedBody:     `"next_cursor":"next"`,
+		},
+		{
+			name:                 "when invalid sort field is provided then should return bad request",
+			query:                "?sort_by=address",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "sort_by: must be a valid value",
+		},
+		{
+			name:                 "when limit is too large then should return bad request",
+			query:                "?limit=1000",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "limit: must be no greater than 100",
+		},
+		{
+			name:           "when tag and metadata filters are provided then should return wallets",
+			query:          "?tag=hot&tag=campaign-2026&metadata=desk:otc",
+			mockService:    true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when tag is invalid then should return bad request",
+			query:                "?tag=not%20a%20tag",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "tag: (0: must be in a valid format.)",
+		},
+		{
+			name:                 "when metadata filter has no value then should return bad request",
+			query:                "?metadata=desk",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "metadata: (0: must be in a valid format.)",
+		},
+		{
+			name:                 "when created_from is not a timestamp then should return bad request",
+			query:                "?created_from=yesterday",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "cannot parse",
+		},
+		{
+			name:                 "when cursor is invalid then should return bad request",
+			query:                "?cursor=broken",
+			mockService:          true,
+			mockReturnErr:        ErrInvalidCursor,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "invalid cursor",
+		},
+		{
+			name:                 "when service returns error then should return internal server error",
+			mockService:          true,
+			mockReturnErr:        errors.New("service error"),
+			expectedStatus:       http.StatusInternalServerError,
+			expectErr:            true,
+			expectedErrorMessage: "service error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := walletmock.NewMockWalletService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().ListWallets(mock.Anything, mock.Anything).
+					Return(tt.mockReturnData, tt.mockReturnCursor, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/wallets"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.ListWallets(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.Contains(t, rec.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestHandler_ListOwnerWallets(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		ownerID              string
+		query                string
+		mockService          bool
+		mockReturnErr        error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when owner is provided then should list the wallets of the owner",
+			ownerID:        "owner1",
+			query:          "?owner_id=owner2",
+			mockService:    true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when caller is not authorized for the owner then should return forbidden",
+			ownerID:              "owner1",
+			mockService:          true,
+			mockReturnErr:        ErrOwnerForbidden,
+			expectedStatus:       http.StatusForbidden,
+			expectErr:            true,
+			expectedErrorMessage: "not authorized for this owner",
+		},
+		{
+			name:                 "when owner is too long then should return bad request",
+			ownerID:              strings.Repeat("a", 65),
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "owner_id: the length must be no more than 64",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := walletmock.NewMockWalletService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().ListWallets(mock.Anything, mock.MatchedBy(func(req *request.ListWalletsRequest) bool {
+					return req.OwnerID == tt.ownerID
+				})).Return(nil, "", tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/owners/:owner_id/wallets"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/owners/:owner_id/wallets")
+			ctx.SetParamNames("owner_id")
+			ctx.SetParamValues(tt.ownerID)
+
+			err := handler.ListOwnerWallets(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_GetWalletByAddress(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		query                string
+		mockService          bool
+		mockReturnData       *entity.Wallet
+		mockReturnErr        error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when valid network and address are provided then should return wallet",
+			query:          "?network=network1&address=address1",
+			mockService:    true,
+			mockReturnData: &entity.Wallet{ID: 1, Addresses: []entity.WalletAddress{{ID: 1, WalletID: 1, Address: "address1", Network: "network1"}}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when address is missing then should return bad request",
+			query:                "?network=network1",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "address: cannot be blank",
+		},
+		{
+			name:                 "when wallet not found then should return not found",
+			query:                "?network=network1&address=address1",
+			mockService:          true,
+			mockReturnErr:        ErrWalletNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "wallet not found",
+		},
+		{
+			name:                 "when service returns error then should return internal server error",
+			query:                "?network=network1&address=address1",
+			mockService:          true,
+			mockReturnErr:        errors.New("service error"),
+			expectedStatus:       http.StatusInternalServerError,
+			expectErr:            true,
+			expectedErrorMessage: "service error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := walletmock.NewMockWalletService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().GetWalletByAddress(mock.Anything, mock.Anything).
+					Return(tt.mockReturnData, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/wallets/by-address"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.GetWalletByAddress(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_GetWalletsByAddresses(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		body                 string
+		mockService          bool
+		mockReturnData       []*entity.Wallet
+		mockReturnNotFound   []entity.WalletKey
+		mockReturnErr        error
+		expectedStatus       int
+		expectedBody         string
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:               "when some wallets are missing then should report them as not found",
+			body:               `{"wallets":[{"network":"network1","address":"address1"},{"network":"network1","address":"address2"}]}`,
+			mockService:        true,
+			mockReturnData:     []*entity.Wallet{{ID: 1, Addresses: []entity.WalletAddress{{ID: 1, WalletID: 1, Address: "address1", Network: "network1"}}}},
+			mockReturnNotFound: []entity.WalletKey{{Network: "network1", Address: "address2"}},
+			expectedStatus:     http.StatusOK,
+			expectedBody:       `"not_found":[{"network":"network1","address":"address2"}]`,
+		},
+		{
+			name:                 "when no keys are provided then should return bad request",
+			body:                 `{"wallets":[]}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "wallets: cannot be blank",
+		},
+		{
+			name:                 "when service returns error then should return internal server error",
+			body:                 `{"wallets":[{"network":"network1","address":"address1"}]}`,
+			mockService:          true,
+			mockReturnErr:        errors.New("service error"),
+			expectedStatus:       http.StatusInternalServerError,
+			expectErr:            true,
+			expectedErrorMessage: "service error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := walletmock.NewMockWalletService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().GetWalletsByAddresses(mock.Anything, mock.Anything).
+					Return(tt.mockReturnData, tt.mockReturnNotFound, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/wallets/by-address/batch", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.GetWalletsByAddresses(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.Contains(t, rec.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestHandler_UpdateWallet(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		walletID             string
+		ifMatch              string
+		body                 string
+		mockService          bool
+		expectedVersion      uint
+		mockReturnData       *entity.Wallet
+		mockReturnErr        error
+		expectedStatus       int
+		expectedETag         string
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:            "when If-Match matches then should update wallet and return new ETag",
+			walletID:        "1",
+			ifMatch:         `"2"`,
+			body:            `{"label":"treasury","metadata":{"desk":"otc"}}`,
+			mockService:     true,
+			expectedVersion: 2,
+			mockReturnData:  &entity.Wallet{ID: 1, Label: "treasury", Version: 3},
+			expectedStatus:  http.StatusOK,
+			expectedETag:    `"3"`,
+		},
+		{
+			name:            "when If-Match is a wildcard then should update without version check",
+			walletID:        "1",
+			ifMatch:         "*",
+			body:            `{"tags":["cold"]}`,
+			mockService:     true,
+			expectedVersion: 0,
+			mockReturnData:  &entity.Wallet{ID: 1, Tags: entity.Tags{"cold"}, Version: 4},
+			expectedStatus:  http.StatusOK,
+			expectedETag:    `"4"`,
+		},
+		{
+			name:                 "when If-Match is missing then should return precondition required",
+			walletID:             "1",
+			body:                 `{"label":"treasury"}`,
+			expectedStatus:       http.StatusPreconditionRequired,
+			expectErr:            true,
+			expectedErrorMessage: "If-Match header is required",
+		},
+		{
+			name:                 "when If-Match is malformed then should return precondition failed",
+			walletID:             "1",
+			ifMatch:              `W/"2"`,
+			body:                 `{"label":"treasury"}`,
+			expectedStatus:       http.StatusPreconditionFailed,
+			expectErr:            true,
+			expectedErrorMessage: "modified by another request",
+		},
+		{
+			name:                 "when no field is provided then should return bad request",
+			walletID:             "1",
+			ifMatch:              `"2"`,
+			body:                 `{}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "at least one field must be provided",
+		},
+		{
+			name:                 "when tag is invalid then should return bad request",
+			walletID:             "1",
+			ifMatch:              `"2"`,
+			body:                 `{"tags":["not a tag"]}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "tags: (0: must be in a valid format.)",
+		},
+		{
+			name:                 "when wallet was modified concurrently then should return precondition failed",
+			walletID:             "1",
+			ifMatch:              `"2"`,
+			body:                 `{"label":"treasury"}`,
+			mockService:          true,
+			expectedVersion:      2,
+			mockReturnErr:        ErrVersionMismatch,
+			expectedStatus:       http.StatusPreconditionFailed,
+			expectErr:            true,
+			expectedErrorMessage: "modified by another request",
+		},
+		{
+			name:                 "when wallet not found then should return not found",
+			walletID:             "1",
+			ifMatch:              `"2"`,
+			body:                 `{"label":"treasury"}`,
+			mockService:          true,
+			expectedVersion:      2,
+			mockReturnErr:        ErrWalletNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "wallet not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := walletmock.NewMockWalletService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().UpdateWallet(mock.Anything, mock.Anything, tt.expectedVersion, mock.Anything).
+					Return(tt.mockReturnData, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/wallets/:id", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.ifMatch != "" {
+				req.Header.Set(HeaderIfMatch, tt.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/wallets/:id")
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.walletID)
+
+			err := handler.UpdateWallet(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.Equal(t, tt.expectedETag, rec.Header().Get(HeaderETag))
+			}
+		})
+	}
+}
+
+func TestHandler_RestoreWallet(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		walletID             string
+		mockService          bool
+		mockReturnData       *entity.Wallet
+		mockReturnErr        error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when deleted wallet is restored then should return wallet",
+			walletID:       "1",
+			mockService:    true,
+			mockReturnData: &entity.Wallet{ID: 1, Addresses: []entity.WalletAddress{{ID: 1, WalletID: 1, Address: "address1", Network: "network1"}}, Version: 2},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when wallet is not deleted then should return conflict",
+			walletID:             "1",
+			mockService:          true,
+			mockReturnErr:        ErrWalletNotDeleted,
+			expectedStatus:       http.StatusConflict,
+			expectErr:            true,
+			expectedErrorMessage: "wallet is not deleted",
+		},
+		{
+			name:                 "when address was registered again then should return conflict",
+			walletID:             "1",
+			mockService:          true,
+			mockReturnErr:        ErrDuplicateAddress,
+			expectedStatus:       http.StatusConflict,
+			expectErr:            true,
+			expectedErrorMessage: "address is already registered",
+		},
+		{
+			name:                 "when wallet not found then should return not found",
+			walletID:             "1",
+			mockService:          true,
+			mockReturnErr:        ErrWalletNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "wallet not found",
+		},
+		{
+			name:                 "when invalid wallet id is provided then should return bad request",
+			walletID:             "not-integer",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "invalid syntax",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := walletmock.NewMockWalletService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().RestoreWallet(mock.Anything, uint(1)).
+					Return(tt.mockReturnData, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/wallets/:id/restore", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/wallets/:id/restore")
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.walletID)
+
+			err := handler.RestoreWallet(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_AttachAddress(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		walletID             string
+		body                 string
+		mockService          bool
+		mockReturnData       *entity.WalletAddress
+		mockReturnErr        error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when valid address is provided then should attach address",
+			walletID:       "1",
+			body:           `{"network":"xrp","address":"address1","memo":"42"}`,
+			mockService:    true,
+			mockReturnData: &entity.WalletAddress{ID: 2, WalletID: 1, Network: "xrp", Address: "address1", Memo: "42"},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:                 "when network is missing then should return bad request",
+			walletID:             "1",
+			body:                 `{"address":"address1"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "network: cannot be blank",
+		},
+		{
+			name:                 "when address is already registered then should return conflict",
+			walletID:             "1",
+			body:                 `{"network":"xrp","address":"address1"}`,
+			mockService:          true,
+			mockReturnErr:        ErrDuplicateAddress,
+			expectedStatus:       http.StatusConflict,
+			expectErr:            true,
+			expectedErrorMessage: "address is already registered",
+		},
+		{
+			name:                 "when wallet not found then should return not found",
+			walletID:             "1",
+			body:                 `{"network":"xrp","address":"address1"}`,
+			mockService:          true,
+			mockReturnErr:        ErrWalletNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "wallet not found",
+		},
+		{
+			name:                 "when invalid wallet id is provided then should return bad request",
+			walletID:             "not-integer",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "invalid syntax",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := walletmock.NewMockWalletService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().AttachAddress(mock.Anything, uint(1), mock.Anything).
+					Return(tt.mockReturnData, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/wallets/:id/addresses", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/wallets/:id/addresses")
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.walletID)
+
+			err := handler.AttachAddress(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_DetachAddress(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		walletID             string
+		addressID            string
+		mockService          bool
+		mockReturnErr        error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when address belongs to wallet then should detach address",
+			walletID:       "1",
+			addressID:      "2",
+			mockService:    true,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:                 "when address not found then should return not found",
+			walletID:             "1",
+			addressID:            "2",
+			mockService:          true,
+			mockReturnErr:        ErrAddressNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "wallet address not found",
+		},
+		{
+			name:                 "when invalid address id is provided then should return bad request",
+			walletID:             "1",
+			addressID:            "not-integer",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "invalid syntax",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := walletmock.NewMockWalletService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().DetachAddress(mock.Anything, uint(1), uint(2)).Return(tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/wallets/:id/addresses/:address_id", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetPath("/wallets/:id/addresses/:address_id")
+			ctx.SetParamNames("id", "address_id")
+			ctx.SetParamValues(tt.walletID, tt.addressID)
+
+			err := handler.DetachAddress(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_TransitionWallet(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		handle               func(h *Handler, ctx echo.Context) error
+		status               string
+		body                 string
+		mockService          bool
+		mockReturnData       *entity.Wallet
+		mockReturnErr        error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when wallet is frozen then should return frozen wallet",
+			handle:         (*Handler).FreezeWallet,
+			status:         entity.StatusFrozen,
+			body:           `{"reason":"sanctions screening"}`,
+			mockService:    true,
+			mockReturnData: &entity.Wallet{ID: 1, Status: entity.StatusFrozen, Version: 2},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "when wallet is activated then should return active wallet",
+			handle:         (*Handler).ActivateWallet,
+			status:         entity.StatusActive,
+			body:           `{"reason":"screening cleared"}`,
+			mockService:    true,
+			mockReturnData: &entity.Wallet{ID: 1, Status: entity.StatusActive, Version: 3},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when reason is missing then should return bad request",
+			handle:               (*Handler).CloseWallet,
+			body:                 `{}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "reason: cannot be blank",
+		},
+		{
+			name:                 "when transition is not allowed then should return conflict",
+			handle:               (*Handler).CloseWallet,
+			status:               entity.StatusClosed,
+			body:                 `{"reason":"customer request"}`,
+			mockService:          true,
+			mockReturnErr:        ErrInvalidStatusTransition,
+			expectedStatus:       http.StatusConflict,
+			expectErr:            true,
+			expectedErrorMessage: "wallet status transition is not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := walletmock.NewMockWalletService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().TransitionWallet(mock.Anything, uint(1), tt.status, mock.Anything).
+					Return(tt.mockReturnData, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/wallets/1/"+tt.status, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues("1")
+
+			err := tt.handle(handler, ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.Equal(t, etag(tt.mockReturnData), rec.Header().Get(HeaderETag))
 			}
 		})
 	}
@@ -1,5 +1,49 @@
 package wallet
 
+import (
+	"fmt"
+	"github.com/safayildirim/wallet-management-service/internal/wallet/entity"
+	"strconv"
+	"strings"
+)
+
+const (
+	HeaderETag    = "ETag"
+	HeaderIfMatch = "If-Match"
+)
+
 type Response struct {
-	Data any `json:"data"`
+	Data       any    `json:"data"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type BatchLookupResult struct {
+	Wallets  []*entity.Wallet   `json:"wallets"`
+	NotFound []entity.WalletKey `json:"not_found"`
+}
+
+// etag returns the entity tag of the wallet's current version.
+func etag(wallet *entity.Wallet) string {
+	return fmt.Sprintf(`"%d"`, wallet.Version)
+}
+
+// parseIfMatch extracts the wallet version from an If-Match header.
+// The wildcard "*" matches any version and is reported as zero.
+func parseIfMatch(header string) (uint, bool) {
+	header = strings.TrimSpace(header)
+	if header == "*" {
+		return 0, true
+	}
+
+	// Versions are compared strongly, so weak validators never match.
+	if !strings.HasPrefix(header, `"`) || !strings.HasSuffix(header, `"`) || len(header) < 2 {
+		return 0, false
+	}
+
+	version, err := strconv.ParseUint(header[1:len(header)-1], 10, 64)
+	if err != nil || version == 0 {
+		return 0, false
+	}
+
+	return uint(version), true
 }
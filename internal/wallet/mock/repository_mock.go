@@ -7,6 +7,8 @@ import (
 
 	entity "github.com/safayildirim/wallet-management-service/internal/wallet/entity"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockWalletRepository is an autogenerated mock type for the Repository type
@@ -22,6 +24,112 @@ func (_m *MockWalletRepository) EXPECT() *MockWalletRepository_Expecter {
 	return &MockWalletRepository_Expecter{mock: &_m.Mock}
 }
 
+// AttachAddress provides a mock function with given fields: ctx, address
+func (_m *MockWalletRepository) AttachAddress(ctx context.Context, address *entity.WalletAddress) (*entity.WalletAddress, error) {
+	ret := _m.Called(ctx, address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachAddress")
+	}
+
+	var r0 *entity.WalletAddress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.WalletAddress) (*entity.WalletAddress, error)); ok {
+		return rf(ctx, address)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.WalletAddress) *entity.WalletAddress); ok {
+		r0 = rf(ctx, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.WalletAddress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.WalletAddress) error); ok {
+		r1 = rf(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_AttachAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachAddress'
+type MockWalletRepository_AttachAddress_Call struct {
+	*mock.Call
+}
+
+// AttachAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address *entity.WalletAddress
+func (_e *MockWalletRepository_Expecter) AttachAddress(ctx interface{}, address interface{}) *MockWalletRepository_AttachAddress_Call {
+	return &MockWalletRepository_AttachAddress_Call{Call: _e.mock.On("AttachAddress", ctx, address)}
+}
+
+func (_c *MockWalletRepository_AttachAddress_Call) Run(run func(ctx context.Context, address *entity.WalletAddress)) *MockWalletRepository_AttachAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.WalletAddress))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_AttachAddress_Call) Return(_a0 *entity.WalletAddress, _a1 error) *MockWalletRepository_AttachAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_AttachAddress_Call) RunAndReturn(run func(context.Context, *entity.WalletAddress) (*entity.WalletAddress, error)) *MockWalletRepository_AttachAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteBackfill provides a mock function with given fields: ctx, name
+func (_m *MockWalletRepository) CompleteBackfill(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteBackfill")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWalletRepository_CompleteBackfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteBackfill'
+type MockWalletRepository_CompleteBackfill_Call struct {
+	*mock.Call
+}
+
+// CompleteBackfill is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockWalletRepository_Expecter) CompleteBackfill(ctx interface{}, name interface{}) *MockWalletRepository_CompleteBackfill_Call {
+	return &MockWalletRepository_CompleteBackfill_Call{Call: _e.mock.On("CompleteBackfill", ctx, name)}
+}
+
+func (_c *MockWalletRepository_CompleteBackfill_Call) Run(run func(ctx context.Context, name string)) *MockWalletRepository_CompleteBackfill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_CompleteBackfill_Call) Return(_a0 error) *MockWalletRepository_CompleteBackfill_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWalletRepository_CompleteBackfill_Call) RunAndReturn(run func(context.Context, string) error) *MockWalletRepository_CompleteBackfill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWallet provides a mock function with given fields: ctx, _a1
 func (_m *MockWalletRepository) CreateWallet(ctx context.Context, _a1 *entity.Wallet) (*entity.Wallet, error) {
 	ret := _m.Called(ctx, _a1)
@@ -128,6 +236,54 @@ func (_c *MockWalletRepository_DeleteWallet_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// DetachAddress provides a mock function with given fields: ctx, walletID, addressID
+func (_m *MockWalletRepository) DetachAddress(ctx context.Context, walletID uint, addressID uint) error {
+	ret := _m.Called(ctx, walletID, addressID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachAddress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, walletID, addressID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWalletRepository_DetachAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachAddress'
+type MockWalletRepository_DetachAddress_Call struct {
+	*mock.Call
+}
+
+// DetachAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID uint
+//   - addressID uint
+func (_e *MockWalletRepository_Expecter) DetachAddress(ctx interface{}, walletID interface{}, addressID interface{}) *MockWalletRepository_DetachAddress_Call {
+	return &MockWalletRepository_DetachAddress_Call{Call: _e.mock.On("DetachAddress", ctx, walletID, addressID)}
+}
+
+func (_c *MockWalletRepository_DetachAddress_Call) Run(run func(ctx context.Context, walletID uint, addressID uint)) *MockWalletRepository_DetachAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_DetachAddress_Call) Return(_a0 error) *MockWalletRepository_DetachAddress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWalletRepository_DetachAddress_Call) RunAndReturn(run func(context.Context, uint, uint) error) *MockWalletRepository_DetachAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWallet provides a mock function with given fields: ctx, id
 func (_m *MockWalletRepository) GetWallet(ctx context.Context, id uint) (*entity.Wallet, error) {
 	ret := _m.Called(ctx, id)
@@ -187,6 +343,703 @@ func (_c *MockWalletRepository_GetWallet_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetWalletByAddress provides a mock function with given fields: ctx, key
+func (_m *MockWalletRepository) GetWalletByAddress(ctx context.Context, key entity.WalletKey) (*entity.Wallet, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWalletByAddress")
+	}
+
+	var r0 *entity.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.WalletKey) (*entity.Wallet, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.WalletKey) *entity.Wallet); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.WalletKey) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_GetWalletByAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWalletByAddress'
+type MockWalletRepository_GetWalletByAddress_Call struct {
+	*mock.Call
+}
+
+// GetWalletByAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key entity.WalletKey
+func (_e *MockWalletRepository_Expecter) GetWalletByAddress(ctx interface{}, key interface{}) *MockWalletRepository_GetWalletByAddress_Call {
+	return &MockWalletRepository_GetWalletByAddress_Call{Call: _e.mock.On("GetWalletByAddress", ctx, key)}
+}
+
+func (_c *MockWalletRepository_GetWalletByAddress_Call) Run(run func(ctx context.Context, key entity.WalletKey)) *MockWalletRepository_GetWalletByAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.WalletKey))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_GetWalletByAddress_Call) Return(_a0 *entity.Wallet, _a1 error) *MockWalletRepository_GetWalletByAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_GetWalletByAddress_Call) RunAndReturn(run func(context.Context, entity.WalletKey) (*entity.Wallet, error)) *MockWalletRepository_GetWalletByAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWalletState provides a mock function with given fields: ctx, id
+func (_m *MockWalletRepository) GetWalletState(ctx context.Context, id uint) (*entity.WalletState, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWalletState")
+	}
+
+	var r0 *entity.WalletState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*entity.WalletState, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *entity.WalletState); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.WalletState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_GetWalletState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWalletState'
+type MockWalletRepository_GetWalletState_Call struct {
+	*mock.Call
+}
+
+// GetWalletState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockWalletRepository_Expecter) GetWalletState(ctx interface{}, id interface{}) *MockWalletRepository_GetWalletState_Call {
+	return &MockWalletRepository_GetWalletState_Call{Call: _e.mock.On("GetWalletState", ctx, id)}
+}
+
+func (_c *MockWalletRepository_GetWalletState_Call) Run(run func(ctx context.Context, id uint)) *MockWalletRepository_GetWalletState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_GetWalletState_Call) Return(_a0 *entity.WalletState, _a1 error) *MockWalletRepository_GetWalletState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_GetWalletState_Call) RunAndReturn(run func(context.Context, uint) (*entity.WalletState, error)) *MockWalletRepository_GetWalletState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWalletsByAddresses provides a mock function with given fields: ctx, keys
+func (_m *MockWalletRepository) GetWalletsByAddresses(ctx context.Context, keys []entity.WalletKey) ([]*entity.Wallet, error) {
+	ret := _m.Called(ctx, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWalletsByAddresses")
+	}
+
+	var r0 []*entity.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.WalletKey) ([]*entity.Wallet, error)); ok {
+		return rf(ctx, keys)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.WalletKey) []*entity.Wallet); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []entity.WalletKey) error); ok {
+		r1 = rf(ctx, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_GetWalletsByAddresses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWalletsByAddresses'
+type MockWalletRepository_GetWalletsByAddresses_Call struct {
+	*mock.Call
+}
+
+// GetWalletsByAddresses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keys []entity.WalletKey
+func (_e *MockWalletRepository_Expecter) GetWalletsByAddresses(ctx interface{}, keys interface{}) *MockWalletRepository_GetWalletsByAddresses_Call {
+	return &MockWalletRepository_GetWalletsByAddresses_Call{Call: _e.mock.On("GetWalletsByAddresses", ctx, keys)}
+}
+
+func (_c *MockWalletRepository_GetWalletsByAddresses_Call) Run(run func(ctx context.Context, keys []entity.WalletKey)) *MockWalletRepository_GetWalletsByAddresses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]entity.WalletKey))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_GetWalletsByAddresses_Call) Return(_a0 []*entity.Wallet, _a1 error) *MockWalletRepository_GetWalletsByAddresses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_GetWalletsByAddresses_Call) RunAndReturn(run func(context.Context, []entity.WalletKey) ([]*entity.Wallet, error)) *MockWalletRepository_GetWalletsByAddresses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsBackfillCompleted provides a mock function with given fields: ctx, name
+func (_m *MockWalletRepository) IsBackfillCompleted(ctx context.Context, name string) (bool, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBackfillCompleted")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_IsBackfillCompleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBackfillCompleted'
+type MockWalletRepository_IsBackfillCompleted_Call struct {
+	*mock.Call
+}
+
+// IsBackfillCompleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockWalletRepository_Expecter) IsBackfillCompleted(ctx interface{}, name interface{}) *MockWalletRepository_IsBackfillCompleted_Call {
+	return &MockWalletRepository_IsBackfillCompleted_Call{Call: _e.mock.On("IsBackfillCompleted", ctx, name)}
+}
+
+func (_c *MockWalletRepository_IsBackfillCompleted_Call) Run(run func(ctx context.Context, name string)) *MockWalletRepository_IsBackfillCompleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_IsBackfillCompleted_Call) Return(_a0 bool, _a1 error) *MockWalletRepository_IsBackfillCompleted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_IsBackfillCompleted_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockWalletRepository_IsBackfillCompleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAddresses provides a mock function with given fields: ctx, afterID, limit
+func (_m *MockWalletRepository) ListAddresses(ctx context.Context, afterID uint, limit int) ([]entity.WalletAddress, error) {
+	ret := _m.Called(ctx, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAddresses")
+	}
+
+	var r0 []entity.WalletAddress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, int) ([]entity.WalletAddress, error)); ok {
+		return rf(ctx, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, int) []entity.WalletAddress); ok {
+		r0 = rf(ctx, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.WalletAddress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, int) error); ok {
+		r1 = rf(ctx, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_ListAddresses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAddresses'
+type MockWalletRepository_ListAddresses_Call struct {
+	*mock.Call
+}
+
+// ListAddresses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - afterID uint
+//   - limit int
+func (_e *MockWalletRepository_Expecter) ListAddresses(ctx interface{}, afterID interface{}, limit interface{}) *MockWalletRepository_ListAddresses_Call {
+	return &MockWalletRepository_ListAddresses_Call{Call: _e.mock.On("ListAddresses", ctx, afterID, limit)}
+}
+
+func (_c *MockWalletRepository_ListAddresses_Call) Run(run func(ctx context.Context, afterID uint, limit int)) *MockWalletRepository_ListAddresses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_ListAddresses_Call) Return(_a0 []entity.WalletAddress, _a1 error) *MockWalletRepository_ListAddresses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_ListAddresses_Call) RunAndReturn(run func(context.Context, uint, int) ([]entity.WalletAddress, error)) *MockWalletRepository_ListAddresses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStatusTransitions provides a mock function with given fields: ctx, walletID
+func (_m *MockWalletRepository) ListStatusTransitions(ctx context.Context, walletID uint) ([]*entity.StatusTransition, error) {
+	ret := _m.Called(ctx, walletID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStatusTransitions")
+	}
+
+	var r0 []*entity.StatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]*entity.StatusTransition, error)); ok {
+		return rf(ctx, walletID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []*entity.StatusTransition); ok {
+		r0 = rf(ctx, walletID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.StatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, walletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_ListStatusTransitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStatusTransitions'
+type MockWalletRepository_ListStatusTransitions_Call struct {
+	*mock.Call
+}
+
+// ListStatusTransitions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID uint
+func (_e *MockWalletRepository_Expecter) ListStatusTransitions(ctx interface{}, walletID interface{}) *MockWalletRepository_ListStatusTransitions_Call {
+	return &MockWalletRepository_ListStatusTransitions_Call{Call: _e.mock.On("ListStatusTransitions", ctx, walletID)}
+}
+
+func (_c *MockWalletRepository_ListStatusTransitions_Call) Run(run func(ctx context.Context, walletID uint)) *MockWalletRepository_ListStatusTransitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_ListStatusTransitions_Call) Return(_a0 []*entity.StatusTransition, _a1 error) *MockWalletRepository_ListStatusTransitions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_ListStatusTransitions_Call) RunAndReturn(run func(context.Context, uint) ([]*entity.StatusTransition, error)) *MockWalletRepository_ListStatusTransitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWallets provides a mock function with given fields: ctx, filter
+func (_m *MockWalletRepository) ListWallets(ctx context.Context, filter entity.WalletFilter) ([]*entity.Wallet, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWallets")
+	}
+
+	var r0 []*entity.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entity.WalletFilter) ([]*entity.Wallet, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entity.WalletFilter) []*entity.Wallet); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entity.WalletFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_ListWallets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWallets'
+type MockWalletRepository_ListWallets_Call struct {
+	*mock.Call
+}
+
+// ListWallets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter entity.WalletFilter
+func (_e *MockWalletRepository_Expecter) ListWallets(ctx interface{}, filter interface{}) *MockWalletRepository_ListWallets_Call {
+	return &MockWalletRepository_ListWallets_Call{Call: _e.mock.On("ListWallets", ctx, filter)}
+}
+
+func (_c *MockWalletRepository_ListWallets_Call) Run(run func(ctx context.Context, filter entity.WalletFilter)) *MockWalletRepository_ListWallets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entity.WalletFilter))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_ListWallets_Call) Return(_a0 []*entity.Wallet, _a1 error) *MockWalletRepository_ListWallets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_ListWallets_Call) RunAndReturn(run func(context.Context, entity.WalletFilter) ([]*entity.Wallet, error)) *MockWalletRepository_ListWallets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedWallets provides a mock function with given fields: ctx, deletedBefore, limit
+func (_m *MockWalletRepository) PurgeDeletedWallets(ctx context.Context, deletedBefore time.Time, limit int) (int64, error) {
+	ret := _m.Called(ctx, deletedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedWallets")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int64, error)); ok {
+		return rf(ctx, deletedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int64); ok {
+		r0 = rf(ctx, deletedBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, deletedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_PurgeDeletedWallets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedWallets'
+type MockWalletRepository_PurgeDeletedWallets_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedWallets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+//   - limit int
+func (_e *MockWalletRepository_Expecter) PurgeDeletedWallets(ctx interface{}, deletedBefore interface{}, limit interface{}) *MockWalletRepository_PurgeDeletedWallets_Call {
+	return &MockWalletRepository_PurgeDeletedWallets_Call{Call: _e.mock.On("PurgeDeletedWallets", ctx, deletedBefore, limit)}
+}
+
+func (_c *MockWalletRepository_PurgeDeletedWallets_Call) Run(run func(ctx context.Context, deletedBefore time.Time, limit int)) *MockWalletRepository_PurgeDeletedWallets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_PurgeDeletedWallets_Call) Return(_a0 int64, _a1 error) *MockWalletRepository_PurgeDeletedWallets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_PurgeDeletedWallets_Call) RunAndReturn(run func(context.Context, time.Time, int) (int64, error)) *MockWalletRepository_PurgeDeletedWallets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreWallet provides a mock function with given fields: ctx, id
+func (_m *MockWalletRepository) RestoreWallet(ctx context.Context, id uint) (*entity.Wallet, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreWallet")
+	}
+
+	var r0 *entity.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*entity.Wallet, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *entity.Wallet); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_RestoreWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreWallet'
+type MockWalletRepository_RestoreWallet_Call struct {
+	*mock.Call
+}
+
+// RestoreWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockWalletRepository_Expecter) RestoreWallet(ctx interface{}, id interface{}) *MockWalletRepository_RestoreWallet_Call {
+	return &MockWalletRepository_RestoreWallet_Call{Call: _e.mock.On("RestoreWallet", ctx, id)}
+}
+
+func (_c *MockWalletRepository_RestoreWallet_Call) Run(run func(ctx context.Context, id uint)) *MockWalletRepository_RestoreWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_RestoreWallet_Call) Return(_a0 *entity.Wallet, _a1 error) *MockWalletRepository_RestoreWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_RestoreWallet_Call) RunAndReturn(run func(context.Context, uint) (*entity.Wallet, error)) *MockWalletRepository_RestoreWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionWallet provides a mock function with given fields: ctx, transition
+func (_m *MockWalletRepository) TransitionWallet(ctx context.Context, transition *entity.StatusTransition) (*entity.Wallet, error) {
+	ret := _m.Called(ctx, transition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionWallet")
+	}
+
+	var r0 *entity.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.StatusTransition) (*entity.Wallet, error)); ok {
+		return rf(ctx, transition)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.StatusTransition) *entity.Wallet); ok {
+		r0 = rf(ctx, transition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.StatusTransition) error); ok {
+		r1 = rf(ctx, transition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_TransitionWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionWallet'
+type MockWalletRepository_TransitionWallet_Call struct {
+	*mock.Call
+}
+
+// TransitionWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transition *entity.StatusTransition
+func (_e *MockWalletRepository_Expecter) TransitionWallet(ctx interface{}, transition interface{}) *MockWalletRepository_TransitionWallet_Call {
+	return &MockWalletRepository_TransitionWallet_Call{Call: _e.mock.On("TransitionWallet", ctx, transition)}
+}
+
+func (_c *MockWalletRepository_TransitionWallet_Call) Run(run func(ctx context.Context, transition *entity.StatusTransition)) *MockWalletRepository_TransitionWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.StatusTransition))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_TransitionWallet_Call) Return(_a0 *entity.Wallet, _a1 error) *MockWalletRepository_TransitionWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_TransitionWallet_Call) RunAndReturn(run func(context.Context, *entity.StatusTransition) (*entity.Wallet, error)) *MockWalletRepository_TransitionWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAddressKey provides a mock function with given fields: ctx, id, key
+func (_m *MockWalletRepository) UpdateAddressKey(ctx context.Context, id uint, key entity.WalletKey) error {
+	ret := _m.Called(ctx, id, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAddressKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, entity.WalletKey) error); ok {
+		r0 = rf(ctx, id, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWalletRepository_UpdateAddressKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAddressKey'
+type MockWalletRepository_UpdateAddressKey_Call struct {
+	*mock.Call
+}
+
+// UpdateAddressKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - key entity.WalletKey
+func (_e *MockWalletRepository_Expecter) UpdateAddressKey(ctx interface{}, id interface{}, key interface{}) *MockWalletRepository_UpdateAddressKey_Call {
+	return &MockWalletRepository_UpdateAddressKey_Call{Call: _e.mock.On("UpdateAddressKey", ctx, id, key)}
+}
+
+func (_c *MockWalletRepository_UpdateAddressKey_Call) Run(run func(ctx context.Context, id uint, key entity.WalletKey)) *MockWalletRepository_UpdateAddressKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(entity.WalletKey))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_UpdateAddressKey_Call) Return(_a0 error) *MockWalletRepository_UpdateAddressKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWalletRepository_UpdateAddressKey_Call) RunAndReturn(run func(context.Context, uint, entity.WalletKey) error) *MockWalletRepository_UpdateAddressKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWallet provides a mock function with given fields: ctx, id, version, changes
+func (_m *MockWalletRepository) UpdateWallet(ctx context.Context, id uint, version uint, changes entity.WalletChanges) (*entity.Wallet, error) {
+	ret := _m.Called(ctx, id, version, changes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWallet")
+	}
+
+	var r0 *entity.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, entity.WalletChanges) (*entity.Wallet, error)); ok {
+		return rf(ctx, id, version, changes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, entity.WalletChanges) *entity.Wallet); ok {
+		r0 = rf(ctx, id, version, changes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, entity.WalletChanges) error); ok {
+		r1 = rf(ctx, id, version, changes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletRepository_UpdateWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWallet'
+type MockWalletRepository_UpdateWallet_Call struct {
+	*mock.Call
+}
+
+// UpdateWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - version uint
+//   - changes entity.WalletChanges
+func (_e *MockWalletRepository_Expecter) UpdateWallet(ctx interface{}, id interface{}, version interface{}, changes interface{}) *MockWalletRepository_UpdateWallet_Call {
+	return &MockWalletRepository_UpdateWallet_Call{Call: _e.mock.On("UpdateWallet", ctx, id, version, changes)}
+}
+
+func (_c *MockWalletRepository_UpdateWallet_Call) Run(run func(ctx context.Context, id uint, version uint, changes entity.WalletChanges)) *MockWalletRepository_UpdateWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(entity.WalletChanges))
+	})
+	return _c
+}
+
+func (_c *MockWalletRepository_UpdateWallet_Call) Return(_a0 *entity.Wallet, _a1 error) *MockWalletRepository_UpdateWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletRepository_UpdateWallet_Call) RunAndReturn(run func(context.Context, uint, uint, entity.WalletChanges) (*entity.Wallet, error)) *MockWalletRepository_UpdateWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWalletRepository creates a new instance of MockWalletRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWalletRepository(t interface {
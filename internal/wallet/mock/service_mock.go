@@ -24,6 +24,123 @@ func (_m *MockWalletService) EXPECT() *MockWalletService_Expecter {
 	return &MockWalletService_Expecter{mock: &_m.Mock}
 }
 
+// AttachAddress provides a mock function with given fields: ctx, walletID, _a2
+func (_m *MockWalletService) AttachAddress(ctx context.Context, walletID uint, _a2 *request.WalletAddressRequest) (*entity.WalletAddress, error) {
+	ret := _m.Called(ctx, walletID, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachAddress")
+	}
+
+	var r0 *entity.WalletAddress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *request.WalletAddressRequest) (*entity.WalletAddress, error)); ok {
+		return rf(ctx, walletID, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, *request.WalletAddressRequest) *entity.WalletAddress); ok {
+		r0 = rf(ctx, walletID, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.WalletAddress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, *request.WalletAddressRequest) error); ok {
+		r1 = rf(ctx, walletID, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletService_AttachAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachAddress'
+type MockWalletService_AttachAddress_Call struct {
+	*mock.Call
+}
+
+// AttachAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID uint
+//   - _a2 *request.WalletAddressRequest
+func (_e *MockWalletService_Expecter) AttachAddress(ctx interface{}, walletID interface{}, _a2 interface{}) *MockWalletService_AttachAddress_Call {
+	return &MockWalletService_AttachAddress_Call{Call: _e.mock.On("AttachAddress", ctx, walletID, _a2)}
+}
+
+func (_c *MockWalletService_AttachAddress_Call) Run(run func(ctx context.Context, walletID uint, _a2 *request.WalletAddressRequest)) *MockWalletService_AttachAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(*request.WalletAddressRequest))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_AttachAddress_Call) Return(_a0 *entity.WalletAddress, _a1 error) *MockWalletService_AttachAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletService_AttachAddress_Call) RunAndReturn(run func(context.Context, uint, *request.WalletAddressRequest) (*entity.WalletAddress, error)) *MockWalletService_AttachAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CanonicalizeAddress provides a mock function with given fields: ctx, _a1
+func (_m *MockWalletService) CanonicalizeAddress(ctx context.Context, _a1 *request.GetWalletByAddressRequest) (entity.WalletKey, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CanonicalizeAddress")
+	}
+
+	var r0 entity.WalletKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.GetWalletByAddressRequest) (entity.WalletKey, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.GetWalletByAddressRequest) entity.WalletKey); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(entity.WalletKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.GetWalletByAddressRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletService_CanonicalizeAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanonicalizeAddress'
+type MockWalletService_CanonicalizeAddress_Call struct {
+	*mock.Call
+}
+
+// CanonicalizeAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *request.GetWalletByAddressRequest
+func (_e *MockWalletService_Expecter) CanonicalizeAddress(ctx interface{}, _a1 interface{}) *MockWalletService_CanonicalizeAddress_Call {
+	return &MockWalletService_CanonicalizeAddress_Call{Call: _e.mock.On("CanonicalizeAddress", ctx, _a1)}
+}
+
+func (_c *MockWalletService_CanonicalizeAddress_Call) Run(run func(ctx context.Context, _a1 *request.GetWalletByAddressRequest)) *MockWalletService_CanonicalizeAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*request.GetWalletByAddressRequest))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_CanonicalizeAddress_Call) Return(_a0 entity.WalletKey, _a1 error) *MockWalletService_CanonicalizeAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletService_CanonicalizeAddress_Call) RunAndReturn(run func(context.Context, *request.GetWalletByAddressRequest) (entity.WalletKey, error)) *MockWalletService_CanonicalizeAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWallet provides a mock function with given fields: ctx, _a1
 func (_m *MockWalletService) CreateWallet(ctx context.Context, _a1 *request.CreateWalletRequest) (*entity.Wallet, error) {
 	ret := _m.Called(ctx, _a1)
@@ -130,6 +247,54 @@ func (_c *MockWalletService_DeleteWallet_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// DetachAddress provides a mock function with given fields: ctx, walletID, addressID
+func (_m *MockWalletService) DetachAddress(ctx context.Context, walletID uint, addressID uint) error {
+	ret := _m.Called(ctx, walletID, addressID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachAddress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint) error); ok {
+		r0 = rf(ctx, walletID, addressID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWalletService_DetachAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachAddress'
+type MockWalletService_DetachAddress_Call struct {
+	*mock.Call
+}
+
+// DetachAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - walletID uint
+//   - addressID uint
+func (_e *MockWalletService_Expecter) DetachAddress(ctx interface{}, walletID interface{}, addressID interface{}) *MockWalletService_DetachAddress_Call {
+	return &MockWalletService_DetachAddress_Call{Call: _e.mock.On("DetachAddress", ctx, walletID, addressID)}
+}
+
+func (_c *MockWalletService_DetachAddress_Call) Run(run func(ctx context.Context, walletID uint, addressID uint)) *MockWalletService_DetachAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_DetachAddress_Call) Return(_a0 error) *MockWalletService_DetachAddress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWalletService_DetachAddress_Call) RunAndReturn(run func(context.Context, uint, uint) error) *MockWalletService_DetachAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWallet provides a mock function with given fields: ctx, id
 func (_m *MockWalletService) GetWallet(ctx context.Context, id uint) (*entity.Wallet, error) {
 	ret := _m.Called(ctx, id)
@@ -189,6 +354,439 @@ func (_c *MockWalletService_GetWallet_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetWalletByAddress provides a mock function with given fields: ctx, _a1
+func (_m *MockWalletService) GetWalletByAddress(ctx context.Context, _a1 *request.GetWalletByAddressRequest) (*entity.Wallet, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWalletByAddress")
+	}
+
+	var r0 *entity.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.GetWalletByAddressRequest) (*entity.Wallet, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.GetWalletByAddressRequest) *entity.Wallet); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.GetWalletByAddressRequest) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletService_GetWalletByAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWalletByAddress'
+type MockWalletService_GetWalletByAddress_Call struct {
+	*mock.Call
+}
+
+// GetWalletByAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *request.GetWalletByAddressRequest
+func (_e *MockWalletService_Expecter) GetWalletByAddress(ctx interface{}, _a1 interface{}) *MockWalletService_GetWalletByAddress_Call {
+	return &MockWalletService_GetWalletByAddress_Call{Call: _e.mock.On("GetWalletByAddress", ctx, _a1)}
+}
+
+func (_c *MockWalletService_GetWalletByAddress_Call) Run(run func(ctx context.Context, _a1 *request.GetWalletByAddressRequest)) *MockWalletService_GetWalletByAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*request.GetWalletByAddressRequest))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_GetWalletByAddress_Call) Return(_a0 *entity.Wallet, _a1 error) *MockWalletService_GetWalletByAddress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletService_GetWalletByAddress_Call) RunAndReturn(run func(context.Context, *request.GetWalletByAddressRequest) (*entity.Wallet, error)) *MockWalletService_GetWalletByAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWalletsByAddresses provides a mock function with given fields: ctx, _a1
+func (_m *MockWalletService) GetWalletsByAddresses(ctx context.Context, _a1 *request.GetWalletsByAddressesRequest) ([]*entity.Wallet, []entity.WalletKey, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWalletsByAddresses")
+	}
+
+	var r0 []*entity.Wallet
+	var r1 []entity.WalletKey
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.GetWalletsByAddressesRequest) ([]*entity.Wallet, []entity.WalletKey, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.GetWalletsByAddressesRequest) []*entity.Wallet); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.GetWalletsByAddressesRequest) []entity.WalletKey); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]entity.WalletKey)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *request.GetWalletsByAddressesRequest) error); ok {
+		r2 = rf(ctx, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockWalletService_GetWalletsByAddresses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWalletsByAddresses'
+type MockWalletService_GetWalletsByAddresses_Call struct {
+	*mock.Call
+}
+
+// GetWalletsByAddresses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *request.GetWalletsByAddressesRequest
+func (_e *MockWalletService_Expecter) GetWalletsByAddresses(ctx interface{}, _a1 interface{}) *MockWalletService_GetWalletsByAddresses_Call {
+	return &MockWalletService_GetWalletsByAddresses_Call{Call: _e.mock.On("GetWalletsByAddresses", ctx, _a1)}
+}
+
+func (_c *MockWalletService_GetWalletsByAddresses_Call) Run(run func(ctx context.Context, _a1 *request.GetWalletsByAddressesRequest)) *MockWalletService_GetWalletsByAddresses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*request.GetWalletsByAddressesRequest))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_GetWalletsByAddresses_Call) Return(_a0 []*entity.Wallet, _a1 []entity.WalletKey, _a2 error) *MockWalletService_GetWalletsByAddresses_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockWalletService_GetWalletsByAddresses_Call) RunAndReturn(run func(context.Context, *request.GetWalletsByAddressesRequest) ([]*entity.Wallet, []entity.WalletKey, error)) *MockWalletService_GetWalletsByAddresses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStatusTransitions provides a mock function with given fields: ctx, id
+func (_m *MockWalletService) ListStatusTransitions(ctx context.Context, id uint) ([]*entity.StatusTransition, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStatusTransitions")
+	}
+
+	var r0 []*entity.StatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]*entity.StatusTransition, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []*entity.StatusTransition); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.StatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletService_ListStatusTransitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStatusTransitions'
+type MockWalletService_ListStatusTransitions_Call struct {
+	*mock.Call
+}
+
+// ListStatusTransitions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockWalletService_Expecter) ListStatusTransitions(ctx interface{}, id interface{}) *MockWalletService_ListStatusTransitions_Call {
+	return &MockWalletService_ListStatusTransitions_Call{Call: _e.mock.On("ListStatusTransitions", ctx, id)}
+}
+
+func (_c *MockWalletService_ListStatusTransitions_Call) Run(run func(ctx context.Context, id uint)) *MockWalletService_ListStatusTransitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_ListStatusTransitions_Call) Return(_a0 []*entity.StatusTransition, _a1 error) *MockWalletService_ListStatusTransitions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletService_ListStatusTransitions_Call) RunAndReturn(run func(context.Context, uint) ([]*entity.StatusTransition, error)) *MockWalletService_ListStatusTransitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWallets provides a mock function with given fields: ctx, _a1
+func (_m *MockWalletService) ListWallets(ctx context.Context, _a1 *request.ListWalletsRequest) ([]*entity.Wallet, string, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWallets")
+	}
+
+	var r0 []*entity.Wallet
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *request.ListWalletsRequest) ([]*entity.Wallet, string, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *request.ListWalletsRequest) []*entity.Wallet); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *request.ListWalletsRequest) string); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *request.ListWalletsRequest) error); ok {
+		r2 = rf(ctx, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockWalletService_ListWallets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWallets'
+type MockWalletService_ListWallets_Call struct {
+	*mock.Call
+}
+
+// ListWallets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *request.ListWalletsRequest
+func (_e *MockWalletService_Expecter) ListWallets(ctx interface{}, _a1 interface{}) *MockWalletService_ListWallets_Call {
+	return &MockWalletService_ListWallets_Call{Call: _e.mock.On("ListWallets", ctx, _a1)}
+}
+
+func (_c *MockWalletService_ListWallets_Call) Run(run func(ctx context.Context, _a1 *request.ListWalletsRequest)) *MockWalletService_ListWallets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*request.ListWalletsRequest))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_ListWallets_Call) Return(_a0 []*entity.Wallet, _a1 string, _a2 error) *MockWalletService_ListWallets_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockWalletService_ListWallets_Call) RunAndReturn(run func(context.Context, *request.ListWalletsRequest) ([]*entity.Wallet, string, error)) *MockWalletService_ListWallets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreWallet provides a mock function with given fields: ctx, id
+func (_m *MockWalletService) RestoreWallet(ctx context.Context, id uint) (*entity.Wallet, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreWallet")
+	}
+
+	var r0 *entity.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*entity.Wallet, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *entity.Wallet); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletService_RestoreWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreWallet'
+type MockWalletService_RestoreWallet_Call struct {
+	*mock.Call
+}
+
+// RestoreWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *MockWalletService_Expecter) RestoreWallet(ctx interface{}, id interface{}) *MockWalletService_RestoreWallet_Call {
+	return &MockWalletService_RestoreWallet_Call{Call: _e.mock.On("RestoreWallet", ctx, id)}
+}
+
+func (_c *MockWalletService_RestoreWallet_Call) Run(run func(ctx context.Context, id uint)) *MockWalletService_RestoreWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_RestoreWallet_Call) Return(_a0 *entity.Wallet, _a1 error) *MockWalletService_RestoreWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletService_RestoreWallet_Call) RunAndReturn(run func(context.Context, uint) (*entity.Wallet, error)) *MockWalletService_RestoreWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionWallet provides a mock function with given fields: ctx, id, status, _a3
+func (_m *MockWalletService) TransitionWallet(ctx context.Context, id uint, status string, _a3 *request.TransitionWalletRequest) (*entity.Wallet, error) {
+	ret := _m.Called(ctx, id, status, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionWallet")
+	}
+
+	var r0 *entity.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, *request.TransitionWalletRequest) (*entity.Wallet, error)); ok {
+		return rf(ctx, id, status, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, string, *request.TransitionWalletRequest) *entity.Wallet); ok {
+		r0 = rf(ctx, id, status, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, string, *request.TransitionWalletRequest) error); ok {
+		r1 = rf(ctx, id, status, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletService_TransitionWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionWallet'
+type MockWalletService_TransitionWallet_Call struct {
+	*mock.Call
+}
+
+// TransitionWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - status string
+//   - _a3 *request.TransitionWalletRequest
+func (_e *MockWalletService_Expecter) TransitionWallet(ctx interface{}, id interface{}, status interface{}, _a3 interface{}) *MockWalletService_TransitionWallet_Call {
+	return &MockWalletService_TransitionWallet_Call{Call: _e.mock.On("TransitionWallet", ctx, id, status, _a3)}
+}
+
+func (_c *MockWalletService_TransitionWallet_Call) Run(run func(ctx context.Context, id uint, status string, _a3 *request.TransitionWalletRequest)) *MockWalletService_TransitionWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(string), args[3].(*request.TransitionWalletRequest))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_TransitionWallet_Call) Return(_a0 *entity.Wallet, _a1 error) *MockWalletService_TransitionWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletService_TransitionWallet_Call) RunAndReturn(run func(context.Context, uint, string, *request.TransitionWalletRequest) (*entity.Wallet, error)) *MockWalletService_TransitionWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWallet provides a mock function with given fields: ctx, id, version, _a3
+func (_m *MockWalletService) UpdateWallet(ctx context.Context, id uint, version uint, _a3 *request.UpdateWalletRequest) (*entity.Wallet, error) {
+	ret := _m.Called(ctx, id, version, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWallet")
+	}
+
+	var r0 *entity.Wallet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *request.UpdateWalletRequest) (*entity.Wallet, error)); ok {
+		return rf(ctx, id, version, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, *request.UpdateWalletRequest) *entity.Wallet); ok {
+		r0 = rf(ctx, id, version, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Wallet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, *request.UpdateWalletRequest) error); ok {
+		r1 = rf(ctx, id, version, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWalletService_UpdateWallet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWallet'
+type MockWalletService_UpdateWallet_Call struct {
+	*mock.Call
+}
+
+// UpdateWallet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+//   - version uint
+//   - _a3 *request.UpdateWalletRequest
+func (_e *MockWalletService_Expecter) UpdateWallet(ctx interface{}, id interface{}, version interface{}, _a3 interface{}) *MockWalletService_UpdateWallet_Call {
+	return &MockWalletService_UpdateWallet_Call{Call: _e.mock.On("UpdateWallet", ctx, id, version, _a3)}
+}
+
+func (_c *MockWalletService_UpdateWallet_Call) Run(run func(ctx context.Context, id uint, version uint, _a3 *request.UpdateWalletRequest)) *MockWalletService_UpdateWallet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint), args[2].(uint), args[3].(*request.UpdateWalletRequest))
+	})
+	return _c
+}
+
+func (_c *MockWalletService_UpdateWallet_Call) Return(_a0 *entity.Wallet, _a1 error) *MockWalletService_UpdateWallet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWalletService_UpdateWallet_Call) RunAndReturn(run func(context.Context, uint, uint, *request.UpdateWalletRequest) (*entity.Wallet, error)) *MockWalletService_UpdateWallet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWalletService creates a new instance of MockWalletService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWalletService(t interface {
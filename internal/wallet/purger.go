@@ -0,0 +1,70 @@
+package wallet
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"github.com/safayildirim/wallet-management-service/pkg/worker"
+	"time"
+)
+
+// Purger periodically hard-deletes wallets that have been soft-deleted for longer
+// than the configured retention.
+type Purger struct {
+	walletRepository Repository
+	retention        time.Duration
+	interval         time.Duration
+	batchSize        int
+}
+
+func NewPurger(walletRepository Repository, conf config.WalletConfig) (*Purger, error) {
+	if err := worker.Validate(conf.PurgeInterval, conf.PurgeBatchSize); err != nil {
+		return nil, errors.Wrap(err, "invalid wallet purger config")
+	}
+
+	return &Purger{
+		walletRepository: walletRepository,
+		retention:        conf.PurgeRetention,
+		interval:         conf.PurgeInterval,
+		batchSize:        conf.PurgeBatchSize,
+	}, nil
+}
+
+// Run purges expired wallets on every tick until the context is cancelled.
+func (p *Purger) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := p.purge(ctx, time.Now().Add(-p.retention))
+		if err != nil {
+			logger.Zap.Sugar().Errorf("wallet purge failed: %v", err)
+		} else if purged > 0 {
+			logger.Zap.Sugar().Infof("purged %d deleted wallets", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge deletes wallets soft-deleted before the cutoff in batches, so that a large
+// backlog does not hold locks on the table for long.
+func (p *Purger) purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	var total int64
+	for {
+		purged, err := p.walletRepository.PurgeDeletedWallets(ctx, cutoff, p.batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		total += purged
+		if purged < int64(p.batchSize) || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
@@ -2,15 +2,35 @@ package wallet
 
 import (
 	"context"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/outbox"
 	"github.com/safayildirim/wallet-management-service/internal/wallet/entity"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type Repository interface {
 	CreateWallet(ctx context.Context, entity *entity.Wallet) (*entity.Wallet, error)
 	GetWallet(ctx context.Context, id uint) (*entity.Wallet, error)
+	GetWalletByAddress(ctx context.Context, key entity.WalletKey) (*entity.Wallet, error)
+	GetWalletsByAddresses(ctx context.Context, keys []entity.WalletKey) ([]*entity.Wallet, error)
+	ListWallets(ctx context.Context, filter entity.WalletFilter) ([]*entity.Wallet, error)
+	UpdateWallet(ctx context.Context, id uint, version uint, changes entity.WalletChanges) (*entity.Wallet, error)
 	DeleteWallet(ctx context.Context, id uint) error
+	RestoreWallet(ctx context.Context, id uint) (*entity.Wallet, error)
+	PurgeDeletedWallets(ctx context.Context, deletedBefore time.Time, limit int) (int64, error)
+	GetWalletState(ctx context.Context, id uint) (*entity.WalletState, error)
+	TransitionWallet(ctx context.Context, transition *entity.StatusTransition) (*entity.Wallet, error)
+	ListStatusTransitions(ctx context.Context, walletID uint) ([]*entity.StatusTransition, error)
+	AttachAddress(ctx context.Context, address *entity.WalletAddress) (*entity.WalletAddress, error)
+	DetachAddress(ctx context.Context, walletID uint, addressID uint) error
+	ListAddresses(ctx context.Context, afterID uint, limit int) ([]entity.WalletAddress, error)
+	UpdateAddressKey(ctx context.Context, id uint, key entity.WalletKey) error
+	IsBackfillCompleted(ctx context.Context, name string) (bool, error)
+	CompleteBackfill(ctx context.Context, name string) error
 }
 
 type repository struct {
@@ -21,35 +41,545 @@ func NewRepository(db *gorm.DB) Repository {
 	return &repository{db: db}
 }
 
-func (r *repository) CreateWallet(ctx context.Context, entity *entity.Wallet) (*entity.Wallet, error) {
-	err := r.db.WithContext(ctx).Create(entity).Error
+// CreateWallet inserts the wallet together with its addresses in a single transaction.
+func (r *repository) CreateWallet(ctx context.Context, wallet *entity.Wallet) (*entity.Wallet, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Addresses").Create(wallet).Error; err != nil {
+			return err
+		}
+
+		if len(wallet.Addresses) > 0 {
+			for i := range wallet.Addresses {
+				wallet.Addresses[i].WalletID = wallet.ID
+			}
+
+			if err := tx.Create(&wallet.Addresses).Error; err != nil {
+				return err
+			}
+		}
+
+		return writeEvent(tx, entity.EventWalletCreated, wallet)
+	})
 	if err != nil {
 		if strings.Contains(err.Error(), "duplicate key") {
-			return nil, ErrDuplicateWallet
+			return nil, ErrDuplicateAddress
 		}
 
 		return nil, err
 	}
 
-	return entity, nil
+	return wallet, nil
 }
 
 func (r *repository) GetWallet(ctx context.Context, id uint) (*entity.Wallet, error) {
 	var item entity.Wallet
-	err := r.db.WithContext(ctx).First(&item, id).Error
+	err := r.db.WithContext(ctx).Preload("Addresses", orderAddresses).First(&item, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWalletNotFound
+		}
+
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// GetWalletByAddress returns the wallet holding the address with the given natural key.
+func (r *repository) GetWalletByAddress(ctx context.Context, key entity.WalletKey) (*entity.Wallet, error) {
+	var item entity.Wallet
+	err := r.db.WithContext(ctx).Preload("Addresses", orderAddresses).
+		Where("id = (?)", r.db.Model(&entity.WalletAddress{}).Select("wallet_id").
+			Where("network = ? AND address = ? AND memo = ?", key.Network, key.Address, key.Memo)).
+		First(&item).Error
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWalletNotFound
+		}
+
 		return nil, err
 	}
 
 	return &item, nil
 }
 
+// GetWalletsByAddresses returns the wallets holding any of the addresses with the given natural keys.
+func (r *repository) GetWalletsByAddresses(ctx context.Context, keys []entity.WalletKey) ([]*entity.Wallet, error) {
+	if len(keys) == 0 {
+		return []*entity.Wallet{}, nil
+	}
+
+	triples := make([][]interface{}, 0, len(keys))
+	for _, key := range keys {
+		triples = append(triples, []interface{}{key.Network, key.Address, key.Memo})
+	}
+
+	var items []*entity.Wallet
+	err := r.db.WithContext(ctx).Preload("Addresses", orderAddresses).
+		Where("id IN (?)", r.db.Model(&entity.WalletAddress{}).Select("wallet_id").
+			Where("(network, address, memo) IN ?", triples)).
+		Order("id").
+		Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *repository) ListWallets(ctx context.Context, filter entity.WalletFilter) ([]*entity.Wallet, error) {
+	query := r.db.WithContext(ctx).Model(&entity.Wallet{}).Preload("Addresses", orderAddresses)
+
+	if filter.OwnerID != "" {
+		query = query.Where("owner_id = ?", filter.OwnerID)
+	}
+
+	// Both address filters have to match the same address of the wallet.
+	if filter.Network != "" || filter.AddressPrefix != "" {
+		addresses := r.db.Model(&entity.WalletAddress{}).Select("1").Where("wallet_addresses.wallet_id = wallets.id")
+		if filter.Network != "" {
+			addresses = addresses.Where("wallet_addresses.network = ?", filter.Network)
+		}
+		if filter.AddressPrefix != "" {
+			addresses = addresses.Where("wallet_addresses.address LIKE ? ESCAPE '\\'", escapeLike(filter.AddressPrefix)+"%")
+		}
+		query = query.Where("EXISTS (?)", addresses)
+	}
+	// Containment is served by the GIN indexes on tags and metadata.
+	if len(filter.Tags) > 0 {
+		query = query.Where("tags @> ?", filter.Tags)
+	}
+	if len(filter.Metadata) > 0 {
+		query = query.Where("metadata @> ?", filter.Metadata)
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("created_at < ?", *filter.CreatedTo)
+	}
+
+	// Keyset pagination: continue strictly after the last row of the previous page,
+	// using id as the tie-breaker so that the ordering is total.
+	op, direction := ">", "ASC"
+	if filter.Descending {
+		op, direction = "<", "DESC"
+	}
+
+	switch filter.SortBy {
+	case entity.SortByCreatedAt:
+		if filter.After != nil {
+			query = query.Where("(created_at, id) "+op+" (?, ?)", filter.After.CreatedAt, filter.After.ID)
+		}
+		query = query.Order("created_at " + direction).Order("id " + direction)
+	default:
+		if filter.After != nil {
+			query = query.Where("id "+op+" ?", filter.After.ID)
+		}
+		query = query.Order("id " + direction)
+	}
+
+	var items []*entity.Wallet
+	err := query.Limit(filter.Limit).Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// UpdateWallet applies the changes to the wallet only if its version still equals the given
+// version, bumping the version on success. A zero version skips the check. Frozen and closed
+// wallets are not changed.
+func (r *repository) UpdateWallet(ctx context.Context, id uint, version uint, changes entity.WalletChanges) (*entity.Wallet, error) {
+	updates := map[string]interface{}{
+		"version":    gorm.Expr("version + 1"),
+		"updated_at": time.Now(),
+	}
+	if changes.Label != nil {
+		updates["label"] = *changes.Label
+	}
+	if changes.Tags != nil {
+		updates["tags"] = *changes.Tags
+	}
+	if changes.Metadata != nil {
+		updates["metadata"] = *changes.Metadata
+	}
+
+	var item entity.Wallet
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&item).Clauses(clause.Returning{}).Scopes(mutable).Where("id = ?", id)
+		if version != 0 {
+			query = query.Where("version = ?", version)
+		}
+
+		result := query.Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			// Either the wallet does not exist, cannot be changed or its version has moved on.
+			if err := immutableError(tx, id); err != nil {
+				return err
+			}
+
+			return ErrVersionMismatch
+		}
+
+		if err := tx.Scopes(orderAddresses).Where("wallet_id = ?", id).Find(&item.Addresses).Error; err != nil {
+			return err
+		}
+
+		return writeEvent(tx, entity.EventWalletUpdated, &item)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// DeleteWallet soft-deletes the wallet and its addresses, releasing the addresses for other wallets.
+// Frozen and closed wallets are not deleted.
 func (r *repository) DeleteWallet(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Scopes(mutable).Delete(&entity.Wallet{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			if err := immutableError(tx, id); err != nil {
+				return err
+			}
+
+			return ErrWalletNotFound
+		}
+
+		if err := tx.Where("wallet_id = ?", id).Delete(&entity.WalletAddress{}).Error; err != nil {
+			return err
+		}
+
+		// The payload carries the wallet as deleted, addresses included.
+		var item entity.Wallet
+		err := tx.Unscoped().Preload("Addresses", func(db *gorm.DB) *gorm.DB {
+			return orderAddresses(db.Unscoped())
+		}).First(&item, id).Error
+		if err != nil {
+			return err
+		}
+
+		return writeEvent(tx, entity.EventWalletDeleted, &item)
+	})
+}
+
+// RestoreWallet clears the soft-delete marker of a deleted wallet and of its addresses.
+func (r *repository) RestoreWallet(ctx context.Context, id uint) (*entity.Wallet, error) {
+	var item entity.Wallet
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Model(&item).Clauses(clause.Returning{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Updates(map[string]interface{}{
+				"deleted_at": nil,
+				"version":    gorm.Expr("version + 1"),
+				"updated_at": time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			if err := tx.First(&entity.Wallet{}, id).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ErrWalletNotFound
+				}
+
+				return err
+			}
+
+			return ErrWalletNotDeleted
+		}
+
+		err := tx.Unscoped().Model(&entity.WalletAddress{}).
+			Where("wallet_id = ? AND deleted_at IS NOT NULL", id).
+			Update("deleted_at", nil).Error
+		if err != nil {
+			if strings.Contains(err.Error(), "duplicate key") {
+				return ErrDuplicateAddress
+			}
+
+			return err
+		}
+
+		if err := tx.Scopes(orderAddresses).Where("wallet_id = ?", id).Find(&item.Addresses).Error; err != nil {
+			return err
+		}
+
+		return writeEvent(tx, entity.EventWalletUpdated, &item)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// PurgeDeletedWallets permanently removes up to limit wallets soft-deleted before the given time.
+func (r *repository) PurgeDeletedWallets(ctx context.Context, deletedBefore time.Time, limit int) (int64, error) {
+	result := r.db.WithContext(ctx).Exec(
+		"DELETE FROM wallets WHERE id IN (SELECT id FROM wallets WHERE deleted_at < ? ORDER BY id LIMIT ?)",
+		deletedBefore, limit,
+	)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// GetWalletState returns the owner and status of a wallet, including a soft-deleted one.
+func (r *repository) GetWalletState(ctx context.Context, id uint) (*entity.WalletState, error) {
+	var state entity.WalletState
+	err := r.db.WithContext(ctx).Unscoped().Model(&entity.Wallet{}).
+		Select("owner_id", "status").Where("id = ?", id).Take(&state).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWalletNotFound
+		}
+
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+// TransitionWallet moves the wallet to the new status only if it is still in the status the
+// transition starts from, bumping the version and recording the transition.
+func (r *repository) TransitionWallet(ctx context.Context, transition *entity.StatusTransition) (*entity.Wallet, error) {
 	var item entity.Wallet
-	err := r.db.WithContext(ctx).Delete(&item, id).Error
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&item).Clauses(clause.Returning{}).
+			Where("id = ? AND status = ?", transition.WalletID, transition.FromStatus).
+			Updates(map[string]interface{}{
+				"status":     transition.ToStatus,
+				"version":    gorm.Expr("version + 1"),
+				"updated_at": time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			// Either the wallet does not exist or its status has moved on.
+			if err := tx.First(&entity.Wallet{}, transition.WalletID).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return ErrWalletNotFound
+				}
+
+				return err
+			}
+
+			return ErrStatusChanged
+		}
+
+		if err := tx.Create(transition).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Scopes(orderAddresses).Where("wallet_id = ?", transition.WalletID).Find(&item.Addresses).Error; err != nil {
+			return err
+		}
+
+		return writeEvent(tx, entity.EventWalletUpdated, &item)
+	})
 	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// ListStatusTransitions returns the status history of a wallet, oldest first.
+func (r *repository) ListStatusTransitions(ctx context.Context, walletID uint) ([]*entity.StatusTransition, error) {
+	var items []*entity.StatusTransition
+	err := r.db.WithContext(ctx).Where("wallet_id = ?", walletID).Order("id").Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// AttachAddress adds the address to its wallet and bumps the wallet version, unless the wallet is
+// frozen or closed.
+func (r *repository) AttachAddress(ctx context.Context, address *entity.WalletAddress) (*entity.WalletAddress, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := touchWallet(tx, address.WalletID); err != nil {
+			return err
+		}
+
+		if err := tx.Create(address).Error; err != nil {
+			return err
+		}
+
+		return writeWalletUpdated(tx, address.WalletID)
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, ErrDuplicateAddress
+		}
+
+		return nil, err
+	}
+
+	return address, nil
+}
+
+// DetachAddress permanently removes the address from its wallet and bumps the wallet version,
+// unless the wallet is frozen or closed.
+func (r *repository) DetachAddress(ctx context.Context, walletID uint, addressID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := touchWallet(tx, walletID); err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Where("id = ? AND wallet_id = ?", addressID, walletID).Delete(&entity.WalletAddress{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return ErrAddressNotFound
+		}
+
+		return writeWalletUpdated(tx, walletID)
+	})
+}
+
+// ListAddresses returns up to limit addresses with an ID above afterID, including those of
+// soft-deleted wallets, ordered by ID.
+func (r *repository) ListAddresses(ctx context.Context, afterID uint, limit int) ([]entity.WalletAddress, error) {
+	var items []entity.WalletAddress
+	err := r.db.WithContext(ctx).Unscoped().
+		Where("id > ?", afterID).
+		Order("id").
+		Limit(limit).
+		Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// UpdateAddressKey rewrites the natural key of an address in place.
+func (r *repository) UpdateAddressKey(ctx context.Context, id uint, key entity.WalletKey) error {
+	err := r.db.WithContext(ctx).Unscoped().Model(&entity.WalletAddress{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"network": key.Network,
+		"address": key.Address,
+		"memo":    key.Memo,
+	}).Error
+	if err != nil && strings.Contains(err.Error(), "duplicate key") {
+		return ErrDuplicateAddress
+	}
+
+	return err
+}
+
+// IsBackfillCompleted reports whether the data backfill of the given name has completed.
+func (r *repository) IsBackfillCompleted(ctx context.Context, name string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Table("backfills").Where("name = ?", name).Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// CompleteBackfill records that the data backfill of the given name has completed.
+func (r *repository) CompleteBackfill(ctx context.Context, name string) error {
+	return r.db.WithContext(ctx).Exec(
+		"INSERT INTO backfills (name) VALUES (?) ON CONFLICT (name) DO NOTHING", name,
+	).Error
+}
+
+// touchWallet bumps the version of a wallet whose addresses change, so that its ETag changes too.
+func touchWallet(tx *gorm.DB, id uint) error {
+	result := tx.Model(&entity.Wallet{}).Scopes(mutable).Where("id = ?", id).Updates(map[string]interface{}{
+		"version":    gorm.Expr("version + 1"),
+		"updated_at": time.Now(),
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		if err := immutableError(tx, id); err != nil {
+			return err
+		}
+
+		return ErrWalletNotFound
+	}
+
+	return nil
+}
+
+// mutable restricts a change to wallets that are neither frozen nor closed. The status is checked
+// by the statement making the change, which waits for a concurrent transition of the wallet and
+// sees its outcome, so a wallet frozen meanwhile is left alone.
+func mutable(db *gorm.DB) *gorm.DB {
+	return db.Where("status NOT IN ?", []string{entity.StatusFrozen, entity.StatusClosed})
+}
+
+// immutableError explains why a change restricted to mutable wallets changed nothing. It returns
+// nil if the wallet exists and may be changed.
+func immutableError(tx *gorm.DB, id uint) error {
+	var item entity.Wallet
+	if err := tx.Select("status").First(&item, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrWalletNotFound
+		}
+
 		return err
 	}
 
+	switch item.Status {
+	case entity.StatusFrozen:
+		return ErrWalletFrozen
+	case entity.StatusClosed:
+		return ErrWalletClosed
+	}
+
 	return nil
 }
+
+// writeEvent records an event about the wallet in the outbox, in the transaction changing the
+// wallet. Every change locks the wallet row before the event is written, so the events of a
+// wallet are recorded in the order their changes are committed.
+func writeEvent(tx *gorm.DB, eventType string, wallet *entity.Wallet) error {
+	return outbox.Write(
+		tx, entity.AggregateWallet, strconv.FormatUint(uint64(wallet.ID), 10), wallet.OwnerID, eventType, wallet,
+	)
+}
+
+// writeWalletUpdated loads the wallet changed in the transaction and records its WalletUpdated event.
+func writeWalletUpdated(tx *gorm.DB, id uint) error {
+	var item entity.Wallet
+	if err := tx.Preload("Addresses", orderAddresses).First(&item, id).Error; err != nil {
+		return err
+	}
+
+	return writeEvent(tx, entity.EventWalletUpdated, &item)
+}
+
+// orderAddresses lists the addresses of a wallet in the order they were attached.
+func orderAddresses(db *gorm.DB) *gorm.DB {
+	return db.Order("wallet_addresses.id")
+}
+
+// escapeLike escapes the LIKE wildcard characters in s.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,43 @@
+package wallet
+
+import (
+	"github.com/safayildirim/wallet-management-service/internal/wallet/entity"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestMutable(t *testing.T) {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DisableAutomaticPing: true})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		statement   func(tx *gorm.DB) *gorm.DB
+		expectedSQL string
+	}{
+		{
+			name: "when wallet is deleted then should skip frozen and closed wallets",
+			statement: func(tx *gorm.DB) *gorm.DB {
+				return tx.Scopes(mutable).Delete(&entity.Wallet{}, 7)
+			},
+			expectedSQL: `WHERE "wallets"."id" = 7 AND status NOT IN ('frozen','closed') AND "wallets"."deleted_at" IS NULL`,
+		},
+		{
+			name: "when wallet is touched then should skip frozen and closed wallets",
+			statement: func(tx *gorm.DB) *gorm.DB {
+				return tx.Model(&entity.Wallet{}).Scopes(mutable).Where("id = ?", 7).Update("label", "main")
+			},
+			expectedSQL: `WHERE id = 7 AND status NOT IN ('frozen','closed') AND "wallets"."deleted_at" IS NULL`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql := db.ToSQL(tt.statement)
+
+			assert.Contains(t, sql, tt.expectedSQL)
+		})
+	}
+}
@@ -1,20 +1,162 @@
 package request
 
 import (
+	"regexp"
+	"time"
+
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/pkg/errors"
 )
 
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+	MaxLookupBatch   = 100
+	MaxAddresses     = 50
+	MaxTags          = 20
+	MaxTagLength     = 64
+	MaxFilters       = 10
+	MaxReasonLength  = 500
+)
+
+var (
+	tagPattern            = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.:-]*$`)
+	metadataFilterPattern = regexp.MustCompile(`^[^:]+:.*$`)
+)
+
+// Tags is a list of wallet tags. Tags are case-insensitive and stored lowercase.
+type Tags []string
+
+func (t Tags) Validate() error {
+	return validation.Validate([]string(t),
+		validation.Length(0, MaxTags),
+		validation.Each(validation.Length(1, MaxTagLength), validation.Match(tagPattern)),
+	)
+}
+
 type CreateWalletRequest struct {
-	Address string `json:"address"`
-	Network string `json:"network"`
+	OwnerID   string                 `json:"owner_id"`
+	Label     string                 `json:"label"`
+	Status    string                 `json:"status"`
+	Tags      Tags                   `json:"tags"`
+	Metadata  map[string]any         `json:"metadata"`
+	Addresses []WalletAddressRequest `json:"addresses"`
 }
 
 func (r CreateWalletRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.OwnerID, validation.Required, validation.Length(1, 64)),
+		validation.Field(&r.Label, validation.Length(0, 255)),
+		validation.Field(&r.Status, validation.In("pending", "active")),
+		validation.Field(&r.Tags),
+		validation.Field(&r.Addresses, validation.Length(0, MaxAddresses)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "wallet create validation error")
+}
+
+// WalletAddressRequest identifies an address on a network, optionally shared and told apart by a memo.
+type WalletAddressRequest struct {
+	Network string `json:"network"`
+	Address string `json:"address"`
+	Memo    string `json:"memo"`
+}
+
+func (r WalletAddressRequest) Validate() error {
 	fields := []*validation.FieldRules{
 		validation.Field(&r.Network, validation.Required),
 		validation.Field(&r.Address, validation.Required),
 	}
 
-	return errors.Wrap(validation.ValidateStruct(&r, fields...), "wallet create validation error")
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "wallet address validation error")
+}
+
+type GetWalletByAddressRequest struct {
+	Network string `json:"network" query:"network"`
+	Address string `json:"address" query:"address"`
+	Memo    string `json:"memo" query:"memo"`
+}
+
+func (r GetWalletByAddressRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Network, validation.Required),
+		validation.Field(&r.Address, validation.Required),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "wallet lookup validation error")
+}
+
+type GetWalletsByAddressesRequest struct {
+	Wallets []GetWalletByAddressRequest `json:"wallets"`
+}
+
+func (r GetWalletsByAddressesRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Wallets, validation.Required, validation.Length(1, MaxLookupBatch)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "wallet lookup validation error")
+}
+
+type UpdateWalletRequest struct {
+	Label    *string         `json:"label"`
+	Tags     *Tags           `json:"tags"`
+	Metadata *map[string]any `json:"metadata"`
+}
+
+func (r UpdateWalletRequest) Validate() error {
+	if r.Label == nil && r.Tags == nil && r.Metadata == nil {
+		return errors.New("wallet update validation error: at least one field must be provided")
+	}
+
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Label, validation.Length(0, 255)),
+		validation.Field(&r.Tags),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "wallet update validation error")
+}
+
+type ListWalletsRequest struct {
+	OwnerID       string     `json:"owner_id" query:"owner_id"`
+	Network       string     `json:"network" query:"network"`
+	AddressPrefix string     `json:"address_prefix" query:"address_prefix"`
+	Tags          Tags       `json:"tag" query:"tag"`
+	Metadata      []string   `json:"metadata" query:"metadata"`
+	CreatedFrom   *time.Time `json:"created_from" query:"created_from"`
+	CreatedTo     *time.Time `json:"created_to" query:"created_to"`
+	SortBy        string     `json:"sort_by" query:"sort_by"`
+	Order         string     `json:"order" query:"order"`
+	Limit         int        `json:"limit" query:"limit"`
+	Cursor        string     `json:"cursor" query:"cursor"`
+}
+
+func (r ListWalletsRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.OwnerID, validation.Length(0, 64)),
+		validation.Field(&r.SortBy, validation.In("id", "created_at")),
+		validation.Field(&r.Order, validation.In("asc", "desc")),
+		validation.Field(&r.Limit, validation.Min(0), validation.Max(MaxListLimit)),
+		validation.Field(&r.Tags, validation.Length(0, MaxFilters)),
+		validation.Field(&r.Metadata, validation.Length(0, MaxFilters), validation.Each(validation.Match(metadataFilterPattern))),
+	}
+
+	if r.CreatedFrom != nil && r.CreatedTo != nil {
+		fields = append(fields, validation.Field(&r.CreatedTo, validation.Min(*r.CreatedFrom)))
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "wallet list validation error")
+}
+
+// TransitionWalletRequest moves a wallet to another status; the reason is kept in the status history.
+type TransitionWalletRequest struct {
+	Reason string `json:"reason"`
+}
+
+func (r TransitionWalletRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Reason, validation.Required, validation.Length(1, MaxReasonLength)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "wallet status transition validation error")
 }
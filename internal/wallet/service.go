@@ -2,39 +2,99 @@ package wallet
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/address"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/auth"
+	"github.com/safayildirim/wallet-management-service/internal/common"
+	"github.com/safayildirim/wallet-management-service/internal/network"
+	networkentity "github.com/safayildirim/wallet-management-service/internal/network/entity"
 	"github.com/safayildirim/wallet-management-service/internal/wallet/entity"
 	"github.com/safayildirim/wallet-management-service/internal/wallet/request"
+	"strings"
+	"time"
 )
 
 type Service interface {
 	CreateWallet(ctx context.Context, request *request.CreateWalletRequest) (*entity.Wallet, error)
 	GetWallet(ctx context.Context, id uint) (*entity.Wallet, error)
+	GetWalletByAddress(ctx context.Context, request *request.GetWalletByAddressRequest) (*entity.Wallet, error)
+	CanonicalizeAddress(ctx context.Context, request *request.GetWalletByAddressRequest) (entity.WalletKey, error)
+	GetWalletsByAddresses(ctx context.Context, request *request.GetWalletsByAddressesRequest) ([]*entity.Wallet, []entity.WalletKey, error)
+	ListWallets(ctx context.Context, request *request.ListWalletsRequest) ([]*entity.Wallet, string, error)
+	UpdateWallet(ctx context.Context, id uint, version uint, request *request.UpdateWalletRequest) (*entity.Wallet, error)
 	DeleteWallet(ctx context.Context, id uint) error
+	RestoreWallet(ctx context.Context, id uint) (*entity.Wallet, error)
+	AttachAddress(ctx context.Context, walletID uint, request *request.WalletAddressRequest) (*entity.WalletAddress, error)
+	DetachAddress(ctx context.Context, walletID uint, addressID uint) error
+	TransitionWallet(ctx context.Context, id uint, status string, request *request.TransitionWalletRequest) (*entity.Wallet, error)
+	ListStatusTransitions(ctx context.Context, id uint) ([]*entity.StatusTransition, error)
 }
 
+const defaultListLimit = request.DefaultListLimit
+
 type service struct {
 	walletRepository Repository
+	networkService   network.Service
+	addressRegistry  *address.Registry
 }
 
-func NewService(walletRepository Repository) Service {
-	return &service{walletRepository: walletRepository}
+func NewService(walletRepository Repository, networkService network.Service, addressRegistry *address.Registry) Service {
+	return &service{walletRepository: walletRepository, networkService: networkService, addressRegistry: addressRegistry}
 }
 
-// CreateWallet creates a new wallet with the provided details.
+// CreateWallet creates a new wallet with the provided details and addresses.
 //
 // Parameters:
 //   - ctx: Context for managing request lifecycle and cancellation.
-//   - request: Request object containing the wallet address and network details.
+//   - request: Request object containing the wallet label, metadata and addresses.
 //
 // Returns:
 //   - The created wallet entity.
-//   - An error if wallet creation fails.
+//   - An error if the caller may not act for the owner, a network is unknown or disabled, an
+//     address or memo is not valid on its network, an address is already registered or wallet
+//     creation fails.
 func (s *service) CreateWallet(ctx context.Context, request *request.CreateWalletRequest) (*entity.Wallet, error) {
+	if err := authorizeOwner(ctx, request.OwnerID); err != nil {
+		return nil, err
+	}
+
 	// Map the request data to the Wallet entity
 	item := entity.Wallet{
-		Address: request.Address,
-		Network: request.Network,
+		OwnerID:   request.OwnerID,
+		Label:     request.Label,
+		Status:    request.Status,
+		Tags:      normalizeTags(request.Tags),
+		Metadata:  request.Metadata,
+		Addresses: make([]entity.WalletAddress, 0, len(request.Addresses)),
+	}
+
+	networks := make(map[string]*networkentity.Network)
+	for i, walletAddress := range request.Addresses {
+		key, err := s.attachableKey(ctx, networks, walletAddress)
+		if err != nil {
+			// Point the caller to the offending address
+			var appErr *apperror.Error
+			if errors.As(err, &appErr) && len(appErr.Fields) > 0 {
+				fields := make(map[string]string, len(appErr.Fields))
+				for name, message := range appErr.Fields {
+					fields[fmt.Sprintf("addresses[%d].%s", i, name)] = message
+				}
+				return nil, appErr.WithFields(fields)
+			}
+
+			return nil, err
+		}
+
+		item.Addresses = append(item.Addresses, entity.WalletAddress{
+			Network: key.Network,
+			Address: key.Address,
+			Memo:    key.Memo,
+		})
 	}
+
 	// Delegate wallet creation to the repository
 	return s.walletRepository.CreateWallet(ctx, &item)
 }
@@ -46,9 +106,252 @@ func (s *service) CreateWallet(ctx context.Context, request *request.CreateWalle
 //
 // Returns:
 //   - The wallet entity if found.
-//   - An error if the wallet does not exist or retrieval fails.
+//   - An error if the wallet does not exist, belongs to another owner or retrieval fails.
 func (s *service) GetWallet(ctx context.Context, id uint) (*entity.Wallet, error) {
-	return s.walletRepository.GetWallet(ctx, id)
+	wallet, err := s.walletRepository.GetWallet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !visible(ctx, wallet) {
+		return nil, ErrWalletNotFound
+	}
+
+	return wallet, nil
+}
+
+// CanonicalizeAddress returns the natural key an address is stored and looked up by.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - request: Request object containing the network, address and optional memo.
+//
+// Returns:
+//   - The natural key with the network code and the canonical address and memo.
+//   - An error if the network is unknown or the address or memo is not valid on it.
+func (s *service) CanonicalizeAddress(ctx context.Context, request *request.GetWalletByAddressRequest) (entity.WalletKey, error) {
+	net, err := s.resolveNetwork(ctx, request.Network)
+	if err != nil {
+		return entity.WalletKey{}, err
+	}
+
+	return canonicalKey(s.addressRegistry, net, request.Address, request.Memo)
+}
+
+// GetWalletByAddress retrieves a wallet by its natural key.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - request: Request object containing the wallet network, address and optional memo.
+//
+// Returns:
+//   - The wallet entity if found.
+//   - An error if the network is unknown, the address or memo is not valid, the wallet does not
+//     exist, belongs to another owner or retrieval fails.
+func (s *service) GetWalletByAddress(ctx context.Context, request *request.GetWalletByAddressRequest) (*entity.Wallet, error) {
+	net, err := s.resolveNetwork(ctx, request.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := canonicalKey(s.addressRegistry, net, request.Address, request.Memo)
+	if err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.walletRepository.GetWalletByAddress(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !visible(ctx, wallet) {
+		return nil, ErrWalletNotFound
+	}
+
+	return wallet, nil
+}
+
+// GetWalletsByAddresses retrieves the wallets matching any of the given natural keys.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - request: Request object containing the natural keys to look up.
+//
+// Returns:
+//   - The wallets that were found.
+//   - The requested keys, as given by the caller, that do not match any wallet the caller may see.
+//   - An error if retrieval fails.
+func (s *service) GetWalletsByAddresses(ctx context.Context, request *request.GetWalletsByAddressesRequest) ([]*entity.Wallet, []entity.WalletKey, error) {
+	keys := make([]entity.WalletKey, 0, len(request.Wallets))
+	requested := make(map[entity.WalletKey]entity.WalletKey, len(request.Wallets))
+	notFound := make([]entity.WalletKey, 0)
+	networks := make(map[string]*networkentity.Network)
+	for _, item := range request.Wallets {
+		given := entity.WalletKey{Network: item.Network, Address: item.Address, Memo: item.Memo}
+
+		code := network.NormalizeCode(item.Network)
+		net, ok := networks[code]
+		if !ok {
+			var err error
+			net, err = s.resolveNetwork(ctx, code)
+			if err != nil && !errors.Is(err, ErrUnknownNetwork) {
+				return nil, nil, err
+			}
+			networks[code] = net
+		}
+
+		// An unknown network or an address or memo that is not valid on it cannot belong to any wallet
+		if net == nil {
+			notFound = append(notFound, given)
+			continue
+		}
+		key, err := canonicalKey(s.addressRegistry, net, item.Address, item.Memo)
+		if err != nil {
+			notFound = append(notFound, given)
+			continue
+		}
+
+		keys = append(keys, key)
+		requested[key] = given
+	}
+
+	wallets, err := s.walletRepository.GetWalletsByAddresses(ctx, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Wallets of other owners are reported as not found
+	found := make([]*entity.Wallet, 0, len(wallets))
+	for _, wallet := range wallets {
+		if !visible(ctx, wallet) {
+			continue
+		}
+		found = append(found, wallet)
+		for _, walletAddress := range wallet.Addresses {
+			delete(requested, walletAddress.Key())
+		}
+	}
+	for _, key := range keys {
+		if given, ok := requested[key]; ok {
+			notFound = append(notFound, given)
+			delete(requested, key)
+		}
+	}
+
+	return found, notFound, nil
+}
+
+// ListWallets returns a single page of wallets matching the request filters.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - request: Request object containing the filters, sort order, page size and cursor.
+//
+// Returns:
+//   - The wallets on the requested page.
+//   - An opaque cursor for the next page, empty when there are no more results.
+//   - An error if the caller may not act for the owner, the cursor is invalid or retrieval fails.
+func (s *service) ListWallets(ctx context.Context, request *request.ListWalletsRequest) ([]*entity.Wallet, string, error) {
+	ownerID := request.OwnerID
+	if scope, ok := auth.ScopeFrom(ctx); ok && ownerID == "" {
+		// A scoped caller only ever lists its own wallets
+		ownerID = scope.OwnerID
+	}
+	if err := authorizeOwner(ctx, ownerID); err != nil {
+		return nil, "", err
+	}
+
+	filter := entity.WalletFilter{
+		OwnerID:       ownerID,
+		Network:       network.NormalizeCode(request.Network),
+		AddressPrefix: request.AddressPrefix,
+		Metadata:      metadataFilter(request.Metadata),
+		CreatedFrom:   request.CreatedFrom,
+		CreatedTo:     request.CreatedTo,
+		SortBy:        request.SortBy,
+		Descending:    request.Order == "desc",
+		Limit:         request.Limit,
+	}
+	if len(request.Tags) > 0 {
+		filter.Tags = normalizeTags(request.Tags)
+	}
+	if filter.SortBy == "" {
+		filter.SortBy = entity.SortByID
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultListLimit
+	}
+
+	if request.Cursor != "" {
+		var cursor walletCursor
+		if err := common.DecodeCursor(request.Cursor, &cursor); err != nil {
+			return nil, "", ErrInvalidCursor
+		}
+		// A cursor is only meaningful for the ordering it was issued for.
+		if cursor.SortBy != filter.SortBy || cursor.Descending != filter.Descending {
+			return nil, "", ErrInvalidCursor
+		}
+		filter.After = &entity.WalletCursor{CreatedAt: cursor.CreatedAt, ID: cursor.ID}
+	}
+
+	// Fetch one extra row to find out whether there is a next page.
+	limit := filter.Limit
+	filter.Limit++
+
+	items, err := s.walletRepository.ListWallets(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(items) <= limit {
+		return items, "", nil
+	}
+
+	items = items[:limit]
+	last := items[len(items)-1]
+	next, err := common.EncodeCursor(walletCursor{
+		SortBy:     filter.SortBy,
+		Descending: filter.Descending,
+		CreatedAt:  last.CreatedAt,
+		ID:         last.ID,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return items, next, nil
+}
+
+// UpdateWallet partially updates a wallet, guarding against lost updates.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - id: The unique identifier of the wallet to update.
+//   - version: The wallet version the caller based its changes on; zero skips the check.
+//   - request: Request object containing the fields to change.
+//
+// Returns:
+//   - The updated wallet entity.
+//   - An error if the wallet does not exist, belongs to another owner, is frozen or closed, was
+//     modified concurrently or the update fails.
+func (s *service) UpdateWallet(ctx context.Context, id uint, version uint, request *request.UpdateWalletRequest) (*entity.Wallet, error) {
+	if err := s.authorizeMutation(ctx, id); err != nil {
+		return nil, err
+	}
+
+	changes := entity.WalletChanges{
+		Label: request.Label,
+	}
+	if request.Tags != nil {
+		tags := normalizeTags(*request.Tags)
+		changes.Tags = &tags
+	}
+	if request.Metadata != nil {
+		metadata := entity.Metadata(*request.Metadata)
+		changes.Metadata = &metadata
+	}
+
+	return s.walletRepository.UpdateWallet(ctx, id, version, changes)
 }
 
 // DeleteWallet deletes a wallet by its unique ID.
@@ -58,7 +361,285 @@ func (s *service) GetWallet(ctx context.Context, id uint) (*entity.Wallet, error
 //   - id: The unique identifier of the wallet to delete.
 //
 // Returns:
-//   - An error if the wallet does not exist or deletion fails.
+//   - An error if the wallet does not exist, belongs to another owner, is frozen or closed or
+//     deletion fails.
 func (s *service) DeleteWallet(ctx context.Context, id uint) error {
+	if err := s.authorizeMutation(ctx, id); err != nil {
+		return err
+	}
+
 	return s.walletRepository.DeleteWallet(ctx, id)
 }
+
+// RestoreWallet restores a soft-deleted wallet by its unique ID.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - id: The unique identifier of the wallet to restore.
+//
+// Returns:
+//   - The restored wallet entity.
+//   - An error if the wallet does not exist, belongs to another owner, is not deleted, its
+//     address was registered again in the meantime or restoration fails.
+func (s *service) RestoreWallet(ctx context.Context, id uint) (*entity.Wallet, error) {
+	if err := s.authorize(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.walletRepository.RestoreWallet(ctx, id)
+}
+
+// AttachAddress adds an address to an existing wallet.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - walletID: The unique identifier of the wallet.
+//   - request: Request object containing the network, address and optional memo.
+//
+// Returns:
+//   - The attached wallet address.
+//   - An error if the network is unknown or disabled, the address or memo is not valid on the
+//     network, the address is already registered, the wallet does not exist, belongs to another
+//     owner, is frozen or closed or attaching fails.
+func (s *service) AttachAddress(ctx context.Context, walletID uint, request *request.WalletAddressRequest) (*entity.WalletAddress, error) {
+	if err := s.authorizeMutation(ctx, walletID); err != nil {
+		return nil, err
+	}
+
+	key, err := s.attachableKey(ctx, make(map[string]*networkentity.Network), *request)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.walletRepository.AttachAddress(ctx, &entity.WalletAddress{
+		WalletID: walletID,
+		Network:  key.Network,
+		Address:  key.Address,
+		Memo:     key.Memo,
+	})
+}
+
+// DetachAddress removes an address from a wallet for good, freeing it for other wallets.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - walletID: The unique identifier of the wallet.
+//   - addressID: The unique identifier of the wallet address.
+//
+// Returns:
+//   - An error if the wallet or the address does not exist, the wallet belongs to another owner,
+//     is frozen or closed or detaching fails.
+func (s *service) DetachAddress(ctx context.Context, walletID uint, addressID uint) error {
+	if err := s.authorizeMutation(ctx, walletID); err != nil {
+		return err
+	}
+
+	return s.walletRepository.DetachAddress(ctx, walletID, addressID)
+}
+
+// TransitionWallet moves a wallet to another status of its lifecycle and records why.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - id: The unique identifier of the wallet.
+//   - status: The status to move the wallet to.
+//   - request: Request object containing the reason for the transition.
+//
+// Returns:
+//   - The wallet entity in its new status.
+//   - An error if the wallet does not exist, belongs to another owner, may not move to the status,
+//     had its status changed concurrently or the transition fails.
+func (s *service) TransitionWallet(ctx context.Context, id uint, status string, request *request.TransitionWalletRequest) (*entity.Wallet, error) {
+	state, err := s.walletState(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !entity.CanTransition(state.Status, status) {
+		return nil, ErrInvalidStatusTransition.WithFields(map[string]string{
+			"status": fmt.Sprintf("cannot change from %s to %s", state.Status, status),
+		})
+	}
+
+	return s.walletRepository.TransitionWallet(ctx, &entity.StatusTransition{
+		WalletID:   id,
+		FromStatus: state.Status,
+		ToStatus:   status,
+		Reason:     request.Reason,
+	})
+}
+
+// ListStatusTransitions returns the status history of a wallet.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - id: The unique identifier of the wallet.
+//
+// Returns:
+//   - The status transitions of the wallet, oldest first.
+//   - An error if the wallet does not exist, belongs to another owner or retrieval fails.
+func (s *service) ListStatusTransitions(ctx context.Context, id uint) ([]*entity.StatusTransition, error) {
+	if _, err := s.walletState(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.walletRepository.ListStatusTransitions(ctx, id)
+}
+
+// authorize makes sure a scoped caller owns the wallet. Wallets of other owners are
+// reported as not found so that their existence is not leaked.
+func (s *service) authorize(ctx context.Context, id uint) error {
+	if _, ok := auth.ScopeFrom(ctx); !ok {
+		return nil
+	}
+
+	_, err := s.walletState(ctx, id)
+	return err
+}
+
+// authorizeMutation makes sure the wallet may be changed by the caller. Frozen and closed
+// wallets cannot be changed at all; the repository checks the status again as it makes the
+// change, so a wallet frozen meanwhile is not changed either.
+func (s *service) authorizeMutation(ctx context.Context, id uint) error {
+	state, err := s.walletState(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	switch state.Status {
+	case entity.StatusFrozen:
+		return ErrWalletFrozen
+	case entity.StatusClosed:
+		return ErrWalletClosed
+	}
+
+	return nil
+}
+
+// walletState returns the state of a wallet the caller may see.
+func (s *service) walletState(ctx context.Context, id uint) (*entity.WalletState, error) {
+	state, err := s.walletRepository.GetWalletState(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if scope, ok := auth.ScopeFrom(ctx); ok && !scope.Allows(state.OwnerID) {
+		return nil, ErrWalletNotFound
+	}
+
+	return state, nil
+}
+
+// authorizeOwner makes sure a scoped caller acts for the given owner.
+func authorizeOwner(ctx context.Context, ownerID string) error {
+	if scope, ok := auth.ScopeFrom(ctx); ok && !scope.Allows(ownerID) {
+		return ErrOwnerForbidden
+	}
+
+	return nil
+}
+
+// visible reports whether the caller may see the wallet.
+func visible(ctx context.Context, wallet *entity.Wallet) bool {
+	scope, ok := auth.ScopeFrom(ctx)
+	return !ok || scope.Allows(wallet.OwnerID)
+}
+
+// attachableKey validates an address that is about to be attached to a wallet and returns its
+// natural key in canonical form. Resolved networks are cached in networks.
+func (s *service) attachableKey(ctx context.Context, networks map[string]*networkentity.Network, walletAddress request.WalletAddressRequest) (entity.WalletKey, error) {
+	code := network.NormalizeCode(walletAddress.Network)
+	net, ok := networks[code]
+	if !ok {
+		var err error
+		net, err = s.resolveNetwork(ctx, code)
+		if err != nil {
+			return entity.WalletKey{}, err
+		}
+		networks[code] = net
+	}
+
+	if !net.Enabled {
+		return entity.WalletKey{}, ErrNetworkDisabled.WithFields(map[string]string{"network": "is disabled"})
+	}
+
+	// Store the canonical form so that the unique constraint catches semantic duplicates
+	return canonicalKey(s.addressRegistry, net, walletAddress.Address, walletAddress.Memo)
+}
+
+// resolveNetwork looks up a registered network by its code.
+func (s *service) resolveNetwork(ctx context.Context, code string) (*networkentity.Network, error) {
+	net, err := s.networkService.GetNetwork(ctx, code)
+	if err != nil {
+		if errors.Is(err, network.ErrNetworkNotFound) {
+			return nil, ErrUnknownNetwork.WithFields(map[string]string{"network": "is not registered"})
+		}
+
+		return nil, err
+	}
+
+	return net, nil
+}
+
+// canonicalKey validates the address and memo against the formats of their network
+// and returns the natural key in canonical form.
+func canonicalKey(addressRegistry *address.Registry, net *networkentity.Network, walletAddress, memo string) (entity.WalletKey, error) {
+	canonical, err := addressRegistry.Canonicalize(net.AddressFormat, walletAddress)
+	if err != nil {
+		return entity.WalletKey{}, err
+	}
+
+	canonicalMemo, err := addressRegistry.CanonicalizeMemo(net.MemoFormat, memo)
+	if err != nil {
+		return entity.WalletKey{}, err
+	}
+
+	return entity.WalletKey{Network: net.Code, Address: canonical, Memo: canonicalMemo}, nil
+}
+
+// normalizeTags lowercases the tags and drops duplicates, keeping the first occurrence.
+func normalizeTags(tags request.Tags) entity.Tags {
+	normalized := make(entity.Tags, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
+}
+
+// metadataFilter turns "key:value" filters into the metadata object a wallet has to contain.
+// A value that is valid JSON is matched as such, so "tier:2" finds {"tier": 2} and "tier:\"2\""
+// finds {"tier": "2"}; any other value is matched as a string.
+func metadataFilter(filters []string) entity.Metadata {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	metadata := make(entity.Metadata, len(filters))
+	for _, filter := range filters {
+		key, value, _ := strings.Cut(filter, ":")
+
+		var parsed any
+		if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+			parsed = value
+		}
+
+		metadata[key] = parsed
+	}
+
+	return metadata
+}
+
+// walletCursor is the decoded form of the opaque cursor returned by ListWallets.
+type walletCursor struct {
+	SortBy     string    `json:"s"`
+	Descending bool      `json:"d"`
+	CreatedAt  time.Time `json:"c"`
+	ID         uint      `json:"i"`
+}
@@ -3,6 +3,13 @@ package wallet
 import (
 	"context"
 	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/address"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/auth"
+	"github.com/safayildirim/wallet-management-service/internal/common"
+	"github.com/safayildirim/wallet-management-service/internal/network"
+	networkentity "github.com/safayildirim/wallet-management-service/internal/network/entity"
+	networkmock "github.com/safayildirim/wallet-management-service/internal/network/mock"
 	"github.com/safayildirim/wallet-management-service/internal/wallet/entity"
 	walletmock "github.com/safayildirim/wallet-management-service/internal/wallet/mock"
 	"github.com/safayildirim/wallet-management-service/internal/wallet/request"
@@ -11,11 +18,14 @@ import (
 	"testing"
 )
 
+var activeState = &entity.WalletState{Status: entity.StatusActive}
+
 func TestService_CreateWallet(t *testing.T) {
 	tests := []struct {
 		name           string
 		request        *request.CreateWalletRequest
 		mockRepository bool
+		expectedEntity *entity.Wallet
 		mockReturn     *entity.Wallet
 		mockError      error
 		expectedResult *entity.Wallet
@@ -24,30 +34,143 @@ func TestService_CreateWallet(t *testing.T) {
 		{
 			name: "when request is valid then should return wallet",
 			request: &request.CreateWalletRequest{
-				Address: "1A2B3C",
-				Network: "Bitcoin",
+				Addresses: []request.WalletAddressRequest{{Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Network: "bitcoin"}},
 			},
 			mockRepository: true,
+			expectedEntity: &entity.Wallet{
+				Tags:      entity.Tags{},
+				Addresses: []entity.WalletAddress{{Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Network: "bitcoin"}},
+			},
 			mockReturn: &entity.Wallet{
-				ID:      1,
-				Address: "1A2B3C",
-				Network: "Bitcoin",
+				ID:        1,
+				Addresses: []entity.WalletAddress{{Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Network: "bitcoin"}},
 			},
 			mockError: nil,
 			expectedResult: &entity.Wallet{
-				ID:      1,
-				Address: "1A2B3C",
-				Network: "Bitcoin",
+				ID:        1,
+				Addresses: []entity.WalletAddress{{Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Network: "bitcoin"}},
 			},
 			expectedError: nil,
 		},
+		{
+			name: "when address is not canonical then should store canonical form",
+			request: &request.CreateWalletRequest{
+				Addresses: []request.WalletAddressRequest{{Address: " 0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359 ", Network: "Ethereum"}},
+			},
+			mockRepository: true,
+			expectedEntity: &entity.Wallet{
+				Tags:      entity.Tags{},
+				Addresses: []entity.WalletAddress{{Address: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", Network: "ethereum"}},
+			},
+			mockReturn: &entity.Wallet{
+				ID:        1,
+				Addresses: []entity.WalletAddress{{Address: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", Network: "ethereum"}},
+			},
+			expectedResult: &entity.Wallet{
+				ID:        1,
+				Addresses: []entity.WalletAddress{{Address: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", Network: "ethereum"}},
+			},
+		},
+		{
+			name: "when addresses on several networks are given then should create one wallet",
+			request: &request.CreateWalletRequest{
+				Label: "main",
+				Addresses: []request.WalletAddressRequest{
+					{Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Network: "bitcoin"},
+					{Address: "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359", Network: "ethereum"},
+				},
+			},
+			mockRepository: true,
+			expectedEntity: &entity.Wallet{
+				Label: "main",
+				Tags:  entity.Tags{},
+				Addresses: []entity.WalletAddress{
+					{Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Network: "bitcoin"},
+					{Address: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", Network: "ethereum"},
+				},
+			},
+			mockReturn:     &entity.Wallet{ID: 1, Label: "main"},
+			expectedResult: &entity.Wallet{ID: 1, Label: "main"},
+		},
+		{
+			name: "when tags are given then should store them lowercase without duplicates",
+			request: &request.CreateWalletRequest{
+				OwnerID: "owner1",
+				Tags:    request.Tags{"Hot", "campaign-2026", "hot"},
+			},
+			mockRepository: true,
+			expectedEntity: &entity.Wallet{
+				OwnerID:   "owner1",
+				Tags:      entity.Tags{"hot", "campaign-2026"},
+				Addresses: []entity.WalletAddress{},
+			},
+			mockReturn:     &entity.Wallet{ID: 1, OwnerID: "owner1", Tags: entity.Tags{"hot", "campaign-2026"}},
+			expectedResult: &entity.Wallet{ID: 1, OwnerID: "owner1", Tags: entity.Tags{"hot", "campaign-2026"}},
+		},
+		{
+			name: "when address is invalid then should return error",
+			request: &request.CreateWalletRequest{
+				Addresses: []request.WalletAddressRequest{{Address: "1A2B3C", Network: "bitcoin"}},
+			},
+			expectedError: address.ErrInvalidAddress,
+		},
+		{
+			name: "when network is not registered then should return error",
+			request: &request.CreateWalletRequest{
+				Addresses: []request.WalletAddressRequest{{Address: "1A2B3C", Network: "network1"}},
+			},
+			expectedError: ErrUnknownNetwork,
+		},
+		{
+			name: "when destination tag is given then should store canonical memo",
+			request: &request.CreateWalletRequest{
+				Addresses: []request.WalletAddressRequest{{Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Network: "xrp", Memo: "00042"}},
+			},
+			mockRepository: true,
+			expectedEntity: &entity.Wallet{
+				Tags:      entity.Tags{},
+				Addresses: []entity.WalletAddress{{Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Network: "xrp", Memo: "42"}},
+			},
+			mockReturn: &entity.Wallet{
+				ID:        1,
+				Addresses: []entity.WalletAddress{{Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Network: "xrp", Memo: "42"}},
+			},
+			expectedResult: &entity.Wallet{
+				ID:        1,
+				Addresses: []entity.WalletAddress{{Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Network: "xrp", Memo: "42"}},
+			},
+		},
+		{
+			name: "when destination tag is invalid then should return error",
+			request: &request.CreateWalletRequest{
+				Addresses: []request.WalletAddressRequest{{Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Network: "xrp", Memo: "customer-42"}},
+			},
+			expectedError: address.ErrInvalidMemo,
+		},
+		{
+			name: "when network does not support memos then should return error",
+			request: &request.CreateWalletRequest{
+				Addresses: []request.WalletAddressRequest{{Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Network: "bitcoin", Memo: "42"}},
+			},
+			expectedError: address.ErrMemoNotSupported,
+		},
+		{
+			name: "when network is disabled then should return error",
+			request: &request.CreateWalletRequest{
+				Addresses: []request.WalletAddressRequest{{Address: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", Network: "ropsten"}},
+			},
+			expectedError: ErrNetworkDisabled,
+		},
 		{
 			name: "when repository returns an error then should return error",
 			request: &request.CreateWalletRequest{
-				Address: "1A2B3C",
-				Network: "Bitcoin",
+				Addresses: []request.WalletAddressRequest{{Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Network: "bitcoin"}},
 			},
 			mockRepository: true,
+			expectedEntity: &entity.Wallet{
+				Tags:      entity.Tags{},
+				Addresses: []entity.WalletAddress{{Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Network: "bitcoin"}},
+			},
 			mockReturn:     nil,
 			mockError:      errors.New("repository error"),
 			expectedResult: nil,
@@ -58,20 +181,18 @@ func TestService_CreateWallet(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepository := walletmock.NewMockWalletRepository(t)
-			s := NewService(mockRepository)
+			s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
 
 			if tt.mockRepository {
-				mockRepository.EXPECT().CreateWallet(mock.Anything, &entity.Wallet{
-					Address: tt.request.Address,
-					Network: tt.request.Network,
-				}).Return(tt.mockReturn, tt.mockError).Once()
+				mockRepository.EXPECT().CreateWallet(mock.Anything, tt.expectedEntity).
+					Return(tt.mockReturn, tt.mockError).Once()
 			}
 
 			result, err := s.CreateWallet(context.Background(), tt.request)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
-				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expectedResult, result)
@@ -95,15 +216,13 @@ func TestService_GetWallet(t *testing.T) {
 			walletID:       1,
 			mockRepository: true,
 			mockReturn: &entity.Wallet{
-				ID:      1,
-				Address: "1A2B3C",
-				Network: "Bitcoin",
+				ID:        1,
+				Addresses: []entity.WalletAddress{{Address: "1A2B3C", Network: "Bitcoin"}},
 			},
 			mockError: nil,
 			expectedResult: &entity.Wallet{
-				ID:      1,
-				Address: "1A2B3C",
-				Network: "Bitcoin",
+				ID:        1,
+				Addresses: []entity.WalletAddress{{Address: "1A2B3C", Network: "Bitcoin"}},
 			},
 			expectedError: nil,
 		},
@@ -130,7 +249,7 @@ func TestService_GetWallet(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepository := walletmock.NewMockWalletRepository(t)
-			s := NewService(mockRepository)
+			s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
 
 			if tt.mockRepository {
 				mockRepository.EXPECT().GetWallet(mock.Anything, tt.walletID).Return(tt.mockReturn, tt.mockError).Once()
@@ -173,13 +292,21 @@ func TestService_DeleteWallet(t *testing.T) {
 			expectedResult: nil,
 			expectedError:  errors.New("repository error"),
 		},
+		{
+			name:           "when wallet is frozen while being deleted then should return error",
+			walletID:       4,
+			mockRepository: true,
+			mockError:      ErrWalletFrozen,
+			expectedError:  ErrWalletFrozen,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepository := walletmock.NewMockWalletRepository(t)
-			s := NewService(mockRepository)
+			s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
 
+			mockRepository.EXPECT().GetWalletState(mock.Anything, tt.walletID).Return(activeState, nil).Once()
 			if tt.mockRepository {
 				mockRepository.EXPECT().DeleteWallet(mock.Anything, tt.walletID).Return(tt.mockError).Once()
 			}
@@ -195,3 +322,676 @@ func TestService_DeleteWallet(t *testing.T) {
 		})
 	}
 }
+
+func TestService_ListWallets(t *testing.T) {
+	wallets := []*entity.Wallet{
+		{ID: 1, Addresses: []entity.WalletAddress{{WalletID: 1, Address: "1A2B3C", Network: "Bitcoin"}}},
+		{ID: 2, Addresses: []entity.WalletAddress{{WalletID: 2, Address: "1A2B3D", Network: "Bitcoin"}}},
+		{ID: 3, Addresses: []entity.WalletAddress{{WalletID: 3, Address: "1A2B3E", Network: "Bitcoin"}}},
+	}
+
+	nextCursor, _ := common.EncodeCursor(walletCursor{SortBy: entity.SortByID, ID: 2})
+	mismatchedCursor, _ := common.EncodeCursor(walletCursor{SortBy: entity.SortByCreatedAt, ID: 2})
+
+	tests := []struct {
+		name               string
+		request            *request.ListWalletsRequest
+		mockRepository     bool
+		expectedFilter     entity.WalletFilter
+		mockReturn         []*entity.Wallet
+		mockError          error
+		expectedResult     []*entity.Wallet
+		expectedNextCursor string
+		expectedError      error
+	}{
+		{
+			name:               "when there are more results then should return next cursor",
+			request:            &request.ListWalletsRequest{Network: "Bitcoin", Limit: 2},
+			mockRepository:     true,
+			expectedFilter:     entity.WalletFilter{Network: "bitcoin", SortBy: entity.SortByID, Limit: 3},
+			mockReturn:         wallets,
+			expectedResult:     wallets[:2],
+			expectedNextCursor: nextCursor,
+		},
+		{
+			name:           "when last page is reached then should return empty cursor",
+			request:        &request.ListWalletsRequest{},
+			mockRepository: true,
+			expectedFilter: entity.WalletFilter{SortBy: entity.SortByID, Limit: request.DefaultListLimit + 1},
+			mockReturn:     wallets,
+			expectedResult: wallets,
+		},
+		{
+			name: "when tag and metadata filters are given then should filter by them",
+			request: &request.ListWalletsRequest{
+				Tags:     request.Tags{"Hot", "campaign-2026"},
+				Metadata: []string{"desk:otc", "region:eu:west"},
+			},
+			mockRepository: true,
+			expectedFilter: entity.WalletFilter{
+				Tags:     entity.Tags{"hot", "campaign-2026"},
+				Metadata: entity.Metadata{"desk": "otc", "region": "eu:west"},
+				SortBy:   entity.SortByID,
+				Limit:    request.DefaultListLimit + 1,
+			},
+			mockReturn:     wallets,
+			expectedResult: wallets,
+		},
+		{
+			name: "when metadata filters are JSON values then should filter by the values",
+			request: &request.ListWalletsRequest{
+				Metadata: []string{"tier:2", "vip:true", `code:"2"`, "limits:{\"daily\":100}"},
+			},
+			mockRepository: true,
+			expectedFilter: entity.WalletFilter{
+				Metadata: entity.Metadata{"tier": float64(2), "vip": true, "code": "2", "limits": map[string]any{"daily": float64(100)}},
+				SortBy:   entity.SortByID,
+				Limit:    request.DefaultListLimit + 1,
+			},
+			mockReturn:     wallets,
+			expectedResult: wallets,
+		},
+		{
+			name:           "when cursor is provided then should continue after it",
+			request:        &request.ListWalletsRequest{Limit: 2, Cursor: nextCursor},
+			mockRepository: true,
+			expectedFilter: entity.WalletFilter{
+				SortBy: entity.SortByID, Limit: 3, After: &entity.WalletCursor{ID: 2},
+			},
+			mockReturn:     wallets[2:],
+			expectedResult: wallets[2:],
+		},
+		{
+			name:          "when cursor is malformed then should return error",
+			request:       &request.ListWalletsRequest{Cursor: "not-a-cursor"},
+			expectedError: ErrInvalidCursor,
+		},
+		{
+			name:          "when cursor was issued for another ordering then should return error",
+			request:       &request.ListWalletsRequest{Cursor: mismatchedCursor},
+			expectedError: ErrInvalidCursor,
+		},
+		{
+			name:           "when repository returns an error then should return error",
+			request:        &request.ListWalletsRequest{},
+			mockRepository: true,
+			expectedFilter: entity.WalletFilter{SortBy: entity.SortByID, Limit: request.DefaultListLimit + 1},
+			mockError:      errors.New("repository error"),
+			expectedError:  errors.New("repository error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := walletmock.NewMockWalletRepository(t)
+			s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+
+			if tt.mockRepository {
+				mockRepository.EXPECT().ListWallets(mock.Anything, tt.expectedFilter).
+					Return(tt.mockReturn, tt.mockError).Once()
+			}
+
+			result, next, err := s.ListWallets(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+				assert.Equal(t, tt.expectedNextCursor, next)
+			}
+		})
+	}
+}
+
+func TestService_GetWalletByAddress(t *testing.T) {
+	tests := []struct {
+		name           string
+		request        *request.GetWalletByAddressRequest
+		mockReturn     *entity.Wallet
+		mockError      error
+		expectedResult *entity.Wallet
+		expectedError  error
+	}{
+		{
+			name:           "when wallet is found then should return wallet",
+			request:        &request.GetWalletByAddressRequest{Network: "Bitcoin", Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+			mockReturn:     &entity.Wallet{ID: 1, Addresses: []entity.WalletAddress{{WalletID: 1, Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Network: "bitcoin"}}},
+			expectedResult: &entity.Wallet{ID: 1, Addresses: []entity.WalletAddress{{WalletID: 1, Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Network: "bitcoin"}}},
+		},
+		{
+			name:          "when wallet is not found then should return error",
+			request:       &request.GetWalletByAddressRequest{Network: "bitcoin", Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+			mockError:     ErrWalletNotFound,
+			expectedError: ErrWalletNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := walletmock.NewMockWalletRepository(t)
+			s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+
+			mockRepository.EXPECT().GetWalletByAddress(mock.Anything, entity.WalletKey{
+				Network: "bitcoin",
+				Address: tt.request.Address,
+			}).Return(tt.mockReturn, tt.mockError).Once()
+
+			result, err := s.GetWalletByAddress(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestService_CanonicalizeAddress(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       *request.GetWalletByAddressRequest
+		expectedKey   entity.WalletKey
+		expectedError error
+	}{
+		{
+			name:        "when address is valid then should return canonical key",
+			request:     &request.GetWalletByAddressRequest{Network: "Ethereum", Address: "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359"},
+			expectedKey: entity.WalletKey{Network: "ethereum", Address: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"},
+		},
+		{
+			name:        "when memo has leading zeros then should return canonical memo",
+			request:     &request.GetWalletByAddressRequest{Network: "xrp", Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Memo: "042"},
+			expectedKey: entity.WalletKey{Network: "xrp", Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Memo: "42"},
+		},
+		{
+			name:          "when address is not valid then should return error",
+			request:       &request.GetWalletByAddressRequest{Network: "bitcoin", Address: "1A2B3C"},
+			expectedError: address.ErrInvalidAddress,
+		},
+		{
+			name:          "when network is not registered then should return error",
+			request:       &request.GetWalletByAddressRequest{Network: "dogecoin", Address: "DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L"},
+			expectedError: ErrUnknownNetwork,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewService(walletmock.NewMockWalletRepository(t), newMockNetworkService(t), address.DefaultRegistry())
+
+			key, err := s.CanonicalizeAddress(context.Background(), tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestService_GetWalletsByAddresses(t *testing.T) {
+	mockRepository := walletmock.NewMockWalletRepository(t)
+	s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+
+	wallets := []*entity.Wallet{
+		{ID: 1, Addresses: []entity.WalletAddress{{WalletID: 1, Address: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", Network: "ethereum"}}},
+		{ID: 2, Addresses: []entity.WalletAddress{{WalletID: 2, Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Network: "xrp", Memo: "42"}}},
+	}
+	mockRepository.EXPECT().GetWalletsByAddresses(mock.Anything, []entity.WalletKey{
+		{Network: "ethereum", Address: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"},
+		{Network: "bitcoin", Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+		{Network: "xrp", Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Memo: "42"},
+		{Network: "xrp", Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Memo: "7"},
+	}).Return(wallets, nil).Once()
+
+	result, notFound, err := s.GetWalletsByAddresses(context.Background(), &request.GetWalletsByAddressesRequest{
+		Wallets: []request.GetWalletByAddressRequest{
+			{Network: "Ethereum", Address: "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359"},
+			{Network: "bitcoin", Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+			{Network: "bitcoin", Address: "1A2B3C"},
+			{Network: "dogecoin", Address: "DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L"},
+			{Network: "xrp", Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Memo: "042"},
+			{Network: "xrp", Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Memo: "7"},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, wallets, result)
+	assert.Equal(t, []entity.WalletKey{
+		{Network: "bitcoin", Address: "1A2B3C"},
+		{Network: "dogecoin", Address: "DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L"},
+		{Network: "bitcoin", Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+		{Network: "xrp", Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Memo: "7"},
+	}, notFound)
+}
+
+func TestService_UpdateWallet(t *testing.T) {
+	label := "treasury"
+	metadata := map[string]any{"desk": "otc"}
+	expectedMetadata := entity.Metadata(metadata)
+	tags := request.Tags{"Cold", "cold"}
+	expectedTags := entity.Tags{"cold"}
+
+	tests := []struct {
+		name            string
+		version         uint
+		request         *request.UpdateWalletRequest
+		expectedChanges entity.WalletChanges
+		mockReturn      *entity.Wallet
+		mockError       error
+		expectedResult  *entity.Wallet
+		expectedError   error
+	}{
+		{
+			name:            "when request is valid then should return updated wallet",
+			version:         2,
+			request:         &request.UpdateWalletRequest{Label: &label, Metadata: &metadata},
+			expectedChanges: entity.WalletChanges{Label: &label, Metadata: &expectedMetadata},
+			mockReturn:      &entity.Wallet{ID: 1, Label: label, Metadata: expectedMetadata, Version: 3},
+			expectedResult:  &entity.Wallet{ID: 1, Label: label, Metadata: expectedMetadata, Version: 3},
+		},
+		{
+			name:            "when tags are given then should replace them",
+			version:         2,
+			request:         &request.UpdateWalletRequest{Tags: &tags},
+			expectedChanges: entity.WalletChanges{Tags: &expectedTags},
+			mockReturn:      &entity.Wallet{ID: 1, Tags: expectedTags, Version: 3},
+			expectedResult:  &entity.Wallet{ID: 1, Tags: expectedTags, Version: 3},
+		},
+		{
+			name:            "when version does not match then should return error",
+			version:         1,
+			request:         &request.UpdateWalletRequest{Label: &label},
+			expectedChanges: entity.WalletChanges{Label: &label},
+			mockError:       ErrVersionMismatch,
+			expectedError:   ErrVersionMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := walletmock.NewMockWalletRepository(t)
+			s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+
+			mockRepository.EXPECT().GetWalletState(mock.Anything, uint(1)).Return(activeState, nil).Once()
+			mockRepository.EXPECT().UpdateWallet(mock.Anything, uint(1), tt.version, tt.expectedChanges).
+				Return(tt.mockReturn, tt.mockError).Once()
+
+			result, err := s.UpdateWallet(context.Background(), 1, tt.version, tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestService_RestoreWallet(t *testing.T) {
+	tests := []struct {
+		name           string
+		walletID       uint
+		mockReturn     *entity.Wallet
+		mockError      error
+		expectedResult *entity.Wallet
+		expectedError  error
+	}{
+		{
+			name:           "when wallet is deleted then should restore wallet",
+			walletID:       1,
+			mockReturn:     &entity.Wallet{ID: 1, Addresses: []entity.WalletAddress{{WalletID: 1, Address: "1A2B3C", Network: "Bitcoin"}}},
+			expectedResult: &entity.Wallet{ID: 1, Addresses: []entity.WalletAddress{{WalletID: 1, Address: "1A2B3C", Network: "Bitcoin"}}},
+		},
+		{
+			name:          "when wallet is not deleted then should return error",
+			walletID:      2,
+			mockError:     ErrWalletNotDeleted,
+			expectedError: ErrWalletNotDeleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := walletmock.NewMockWalletRepository(t)
+			s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+
+			mockRepository.EXPECT().RestoreWallet(mock.Anything, tt.walletID).Return(tt.mockReturn, tt.mockError).Once()
+
+			result, err := s.RestoreWallet(context.Background(), tt.walletID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+// newMockNetworkService returns a network service that knows the networks used in these tests.
+func newMockNetworkService(t *testing.T) *networkmock.MockNetworkService {
+	networks := map[string]*networkentity.Network{
+		"bitcoin":  {Code: "bitcoin", AddressFormat: address.FormatBitcoin, Enabled: true},
+		"ethereum": {Code: "ethereum", AddressFormat: address.FormatEVM, Enabled: true},
+		"ropsten":  {Code: "ropsten", AddressFormat: address.FormatEVM, Testnet: true},
+		"xrp": {
+			Code: "xrp", AddressFormat: address.FormatXRP, MemoFormat: address.MemoFormatDestinationTag, Enabled: true,
+		},
+	}
+
+	mockService := networkmock.NewMockNetworkService(t)
+	mockService.EXPECT().GetNetwork(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, code string) (*networkentity.Network, error) {
+			if item, ok := networks[network.NormalizeCode(code)]; ok {
+				return item, nil
+			}
+			return nil, network.ErrNetworkNotFound
+		}).Maybe()
+
+	return mockService
+}
+
+func TestService_CreateWallet_InvalidAddressField(t *testing.T) {
+	mockRepository := walletmock.NewMockWalletRepository(t)
+	s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+
+	_, err := s.CreateWallet(context.Background(), &request.CreateWalletRequest{
+		Addresses: []request.WalletAddressRequest{
+			{Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", Network: "bitcoin"},
+			{Address: "1A2B3C", Network: "bitcoin"},
+		},
+	})
+
+	var appErr *apperror.Error
+	assert.ErrorAs(t, err, &appErr)
+	assert.ErrorIs(t, err, address.ErrInvalidAddress)
+	assert.Contains(t, appErr.Fields, "addresses[1].address")
+}
+
+func TestService_AttachAddress(t *testing.T) {
+	tests := []struct {
+		name           string
+		request        *request.WalletAddressRequest
+		mockRepository bool
+		expectedEntity *entity.WalletAddress
+		mockError      error
+		expectedError  error
+	}{
+		{
+			name:           "when address is valid then should attach canonical address",
+			request:        &request.WalletAddressRequest{Network: "XRP", Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Memo: "007"},
+			mockRepository: true,
+			expectedEntity: &entity.WalletAddress{WalletID: 1, Network: "xrp", Address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Memo: "7"},
+		},
+		{
+			name:          "when network is disabled then should return error",
+			request:       &request.WalletAddressRequest{Network: "ropsten", Address: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"},
+			expectedError: ErrNetworkDisabled,
+		},
+		{
+			name:           "when wallet does not exist then should return error",
+			request:        &request.WalletAddressRequest{Network: "bitcoin", Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+			mockRepository: true,
+			expectedEntity: &entity.WalletAddress{WalletID: 1, Network: "bitcoin", Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+			mockError:      ErrWalletNotFound,
+			expectedError:  ErrWalletNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := walletmock.NewMockWalletRepository(t)
+			s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+
+			mockRepository.EXPECT().GetWalletState(mock.Anything, uint(1)).Return(activeState, nil).Once()
+			if tt.mockRepository {
+				var mockReturn *entity.WalletAddress
+				if tt.mockError == nil {
+					mockReturn = tt.expectedEntity
+				}
+				mockRepository.EXPECT().AttachAddress(mock.Anything, tt.expectedEntity).Return(mockReturn, tt.mockError).Once()
+			}
+
+			result, err := s.AttachAddress(context.Background(), 1, tt.request)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedEntity, result)
+			}
+		})
+	}
+}
+
+func TestService_DetachAddress(t *testing.T) {
+	mockRepository := walletmock.NewMockWalletRepository(t)
+	s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+
+	mockRepository.EXPECT().GetWalletState(mock.Anything, uint(1)).Return(activeState, nil).Once()
+	mockRepository.EXPECT().DetachAddress(mock.Anything, uint(1), uint(2)).Return(ErrAddressNotFound).Once()
+
+	err := s.DetachAddress(context.Background(), 1, 2)
+
+	assert.ErrorIs(t, err, ErrAddressNotFound)
+}
+
+func TestService_OwnerScope(t *testing.T) {
+	ctx := auth.WithScope(context.Background(), auth.Scope{OwnerID: "owner1"})
+	own := &entity.Wallet{ID: 1, OwnerID: "owner1"}
+	foreign := &entity.Wallet{ID: 2, OwnerID: "owner2"}
+
+	t.Run("when wallet belongs to the caller then should return wallet", func(t *testing.T) {
+		mockRepository := walletmock.NewMockWalletRepository(t)
+		s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+		mockRepository.EXPECT().GetWallet(mock.Anything, uint(1)).Return(own, nil).Once()
+
+		result, err := s.GetWallet(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, own, result)
+	})
+
+	t.Run("when wallet belongs to another owner then should return not found", func(t *testing.T) {
+		mockRepository := walletmock.NewMockWalletRepository(t)
+		s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+		mockRepository.EXPECT().GetWallet(mock.Anything, uint(2)).Return(foreign, nil).Once()
+
+		_, err := s.GetWallet(ctx, 2)
+
+		assert.ErrorIs(t, err, ErrWalletNotFound)
+	})
+
+	t.Run("when deleting a wallet of another owner then should return not found", func(t *testing.T) {
+		mockRepository := walletmock.NewMockWalletRepository(t)
+		s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+		mockRepository.EXPECT().GetWalletState(mock.Anything, uint(2)).Return(&entity.WalletState{OwnerID: "owner2", Status: entity.StatusActive}, nil).Once()
+
+		err := s.DeleteWallet(ctx, 2)
+
+		assert.ErrorIs(t, err, ErrWalletNotFound)
+	})
+
+	t.Run("when detaching an address of an own wallet then should detach address", func(t *testing.T) {
+		mockRepository := walletmock.NewMockWalletRepository(t)
+		s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+		mockRepository.EXPECT().GetWalletState(mock.Anything, uint(1)).Return(&entity.WalletState{OwnerID: "owner1", Status: entity.StatusActive}, nil).Once()
+		mockRepository.EXPECT().DetachAddress(mock.Anything, uint(1), uint(3)).Return(nil).Once()
+
+		err := s.DetachAddress(ctx, 1, 3)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("when creating a wallet for another owner then should return forbidden", func(t *testing.T) {
+		mockRepository := walletmock.NewMockWalletRepository(t)
+		s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+
+		_, err := s.CreateWallet(ctx, &request.CreateWalletRequest{OwnerID: "owner2"})
+
+		assert.ErrorIs(t, err, ErrOwnerForbidden)
+	})
+
+	t.Run("when listing without an owner then should list the wallets of the caller", func(t *testing.T) {
+		mockRepository := walletmock.NewMockWalletRepository(t)
+		s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+		mockRepository.EXPECT().ListWallets(mock.Anything, mock.MatchedBy(func(filter entity.WalletFilter) bool {
+			return filter.OwnerID == "owner1"
+		})).Return([]*entity.Wallet{own}, nil).Once()
+
+		result, _, err := s.ListWallets(ctx, &request.ListWalletsRequest{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entity.Wallet{own}, result)
+	})
+
+	t.Run("when listing the wallets of another owner then should return forbidden", func(t *testing.T) {
+		mockRepository := walletmock.NewMockWalletRepository(t)
+		s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+
+		_, _, err := s.ListWallets(ctx, &request.ListWalletsRequest{OwnerID: "owner2"})
+
+		assert.ErrorIs(t, err, ErrOwnerForbidden)
+	})
+
+	t.Run("when looking up wallets of another owner then should report them as not found", func(t *testing.T) {
+		mockRepository := walletmock.NewMockWalletRepository(t)
+		s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+		mockRepository.EXPECT().GetWalletsByAddresses(mock.Anything, mock.Anything).Return([]*entity.Wallet{{
+			ID:        2,
+			OwnerID:   "owner2",
+			Addresses: []entity.WalletAddress{{WalletID: 2, Network: "bitcoin", Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}},
+		}}, nil).Once()
+
+		result, notFound, err := s.GetWalletsByAddresses(ctx, &request.GetWalletsByAddressesRequest{
+			Wallets: []request.GetWalletByAddressRequest{{Network: "bitcoin", Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}},
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		assert.Equal(t, []entity.WalletKey{{Network: "bitcoin", Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"}}, notFound)
+	})
+}
+
+func TestService_TransitionWallet(t *testing.T) {
+	tests := []struct {
+		name               string
+		state              *entity.WalletState
+		status             string
+		mockRepository     bool
+		mockError          error
+		expectedTransition *entity.StatusTransition
+		expectedError      error
+	}{
+		{
+			name:               "when wallet is active then should freeze wallet",
+			state:              &entity.WalletState{Status: entity.StatusActive},
+			status:             entity.StatusFrozen,
+			mockRepository:     true,
+			expectedTransition: &entity.StatusTransition{WalletID: 1, FromStatus: entity.StatusActive, ToStatus: entity.StatusFrozen, Reason: "sanctions screening"},
+		},
+		{
+			name:               "when wallet is frozen then should activate wallet",
+			state:              &entity.WalletState{Status: entity.StatusFrozen},
+			status:             entity.StatusActive,
+			mockRepository:     true,
+			expectedTransition: &entity.StatusTransition{WalletID: 1, FromStatus: entity.StatusFrozen, ToStatus: entity.StatusActive, Reason: "sanctions screening"},
+		},
+		{
+			name:          "when wallet is frozen then should not close wallet",
+			state:         &entity.WalletState{Status: entity.StatusFrozen},
+			status:        entity.StatusClosed,
+			expectedError: ErrInvalidStatusTransition,
+		},
+		{
+			name:          "when wallet is closed then should not activate wallet",
+			state:         &entity.WalletState{Status: entity.StatusClosed},
+			status:        entity.StatusActive,
+			expectedError: ErrInvalidStatusTransition,
+		},
+		{
+			name:               "when status changed concurrently then should return error",
+			state:              &entity.WalletState{Status: entity.StatusPending},
+			status:             entity.StatusActive,
+			mockRepository:     true,
+			mockError:          ErrStatusChanged,
+			expectedTransition: &entity.StatusTransition{WalletID: 1, FromStatus: entity.StatusPending, ToStatus: entity.StatusActive, Reason: "sanctions screening"},
+			expectedError:      ErrStatusChanged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := walletmock.NewMockWalletRepository(t)
+			s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+
+			mockRepository.EXPECT().GetWalletState(mock.Anything, uint(1)).Return(tt.state, nil).Once()
+			if tt.mockRepository {
+				var mockReturn *entity.Wallet
+				if tt.mockError == nil {
+					mockReturn = &entity.Wallet{ID: 1, Status: tt.status}
+				}
+				mockRepository.EXPECT().TransitionWallet(mock.Anything, tt.expectedTransition).Return(mockReturn, tt.mockError).Once()
+			}
+
+			result, err := s.TransitionWallet(context.Background(), 1, tt.status, &request.TransitionWalletRequest{Reason: "sanctions screening"})
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.status, result.Status)
+			}
+		})
+	}
+}
+
+func TestService_FrozenWallet(t *testing.T) {
+	label := "treasury"
+
+	tests := []struct {
+		name          string
+		status        string
+		expectedError error
+	}{
+		{
+			name:          "when wallet is frozen then should reject changes",
+			status:        entity.StatusFrozen,
+			expectedError: ErrWalletFrozen,
+		},
+		{
+			name:          "when wallet is closed then should reject changes",
+			status:        entity.StatusClosed,
+			expectedError: ErrWalletClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := walletmock.NewMockWalletRepository(t)
+			s := NewService(mockRepository, newMockNetworkService(t), address.DefaultRegistry())
+			mockRepository.EXPECT().GetWalletState(mock.Anything, uint(1)).Return(&entity.WalletState{Status: tt.status}, nil)
+
+			_, err := s.UpdateWallet(context.Background(), 1, 0, &request.UpdateWalletRequest{Label: &label})
+			assert.ErrorIs(t, err, tt.expectedError)
+
+			err = s.DeleteWallet(context.Background(), 1)
+			assert.ErrorIs(t, err, tt.expectedError)
+
+			_, err = s.AttachAddress(context.Background(), 1, &request.WalletAddressRequest{Network: "bitcoin", Address: "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"})
+			assert.ErrorIs(t, err, tt.expectedError)
+
+			err = s.DetachAddress(context.Background(), 1, 2)
+			assert.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
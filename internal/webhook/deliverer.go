@@ -0,0 +1,260 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/webhook/entity"
+	"github.com/safayildirim/wallet-management-service/internal/webhook/request"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"github.com/safayildirim/wallet-management-service/pkg/worker"
+	"gopkg.in/guregu/null.v3"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	HeaderWebhookID        = "Webhook-Id"
+	HeaderWebhookEvent     = "Webhook-Event"
+	HeaderWebhookTimestamp = "Webhook-Timestamp"
+	HeaderWebhookSignature = "Webhook-Signature"
+
+	// signatureVersion prefixes the signature, leaving room for other schemes.
+	signatureVersion = "v1"
+	// maxResponseBody is the number of bytes of a response read before the connection is
+	// released; the body itself is ignored.
+	maxResponseBody = 64 << 10
+)
+
+var ErrForbiddenAddress = errors.New("endpoint resolves to a local or private address")
+
+// Deliverer periodically posts the deliveries that are due to the endpoints of their
+// subscriptions, retrying failed ones with an exponential backoff.
+type Deliverer struct {
+	webhookRepository    Repository
+	client               *http.Client
+	interval             time.Duration
+	batchSize            int
+	lease                time.Duration
+	maxAttempts          int
+	retryBackoff         time.Duration
+	maxRetryBackoff      time.Duration
+	disableAfterFailures int
+	// jitter picks the actual delay before a retry given the computed one.
+	jitter func(delay time.Duration) time.Duration
+}
+
+func NewDeliverer(webhookRepository Repository, conf config.WebhookConfig) (*Deliverer, error) {
+	if err := worker.Validate(conf.DeliveryInterval, conf.DeliveryBatchSize); err != nil {
+		return nil, errors.Wrap(err, "invalid webhook deliverer config")
+	}
+	if conf.DeliveryTimeout <= 0 {
+		return nil, errors.New("invalid webhook deliverer config: delivery timeout must be positive")
+	}
+
+	return &Deliverer{
+		webhookRepository: webhookRepository,
+		client:            newClient(conf.DeliveryTimeout, request.IsPublicIP),
+		interval:          conf.DeliveryInterval,
+		batchSize:         conf.DeliveryBatchSize,
+		// A claimed delivery is handed out again only once its attempt is sure to have timed out.
+		lease:                2 * conf.DeliveryTimeout,
+		maxAttempts:          conf.MaxAttempts,
+		retryBackoff:         conf.RetryBackoff,
+		maxRetryBackoff:      conf.MaxRetryBackoff,
+		disableAfterFailures: conf.DisableAfterFailures,
+		jitter:               equalJitter,
+	}, nil
+}
+
+// newClient returns the client deliveries are posted with. It connects only to addresses that
+// are allowed, checked once the host name is resolved so that a name cannot be pointed at an
+// internal address after validation, and does not follow redirects, which would otherwise lead
+// it anywhere. Proxies are not used, as the address of the endpoint could not be checked.
+func newClient(timeout time.Duration, allowed func(ip netip.Addr) bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return errors.Wrapf(err, "dialing %s", address)
+			}
+			if !allowed(addrPort.Addr()) {
+				return errors.Wrapf(ErrForbiddenAddress, "dialing %s", address)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Run attempts due deliveries on every tick until the context is cancelled.
+func (d *Deliverer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		attempted, err := d.deliver(ctx)
+		if err != nil {
+			logger.Zap.Sugar().Errorf("webhook delivery failed: %v", err)
+		} else if attempted > 0 {
+			logger.Zap.Sugar().Debugf("attempted %d webhook deliveries", attempted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliver attempts due deliveries in batches. The deliveries of a batch are attempted
+// concurrently, so a slow endpoint holds up a batch for at most the delivery timeout.
+func (d *Deliverer) deliver(ctx context.Context) (int, error) {
+	var total int
+	for {
+		deliveries, err := d.webhookRepository.ClaimDeliveries(ctx, time.Now(), d.lease, d.batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		var wg sync.WaitGroup
+		for _, delivery := range deliveries {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				d.attempt(ctx, delivery)
+			}()
+		}
+		wg.Wait()
+
+		total += len(deliveries)
+		if len(deliveries) < d.batchSize || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
+
+// attempt posts a delivery and records the outcome. An outcome that cannot be recorded is
+// attempted again once the lease of the delivery runs out.
+func (d *Deliverer) attempt(ctx context.Context, delivery *entity.Delivery) {
+	attempt := d.post(ctx, delivery)
+	d.apply(delivery, attempt)
+
+	err := d.webhookRepository.RecordAttempt(ctx, delivery, attempt.Succeeded(), d.disableAfterFailures)
+	if err != nil {
+		logger.Zap.Sugar().Errorf("recording attempt of webhook delivery %d failed: %v", delivery.ID, err)
+	}
+}
+
+// post signs the payload of a delivery with the secret of its subscription and posts it to the
+// endpoint.
+func (d *Deliverer) post(ctx context.Context, delivery *entity.Delivery) entity.Attempt {
+	attempt := entity.Attempt{At: time.Now()}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+
+	timestamp := strconv.FormatInt(attempt.At.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookID, strconv.FormatUint(uint64(delivery.EventID), 10))
+	req.Header.Set(HeaderWebhookEvent, delivery.EventType)
+	req.Header.Set(HeaderWebhookTimestamp, timestamp)
+	req.Header.Set(HeaderWebhookSignature, sign(delivery.Subscription.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	attempt.StatusCode = resp.StatusCode
+	if !attempt.Succeeded() {
+		attempt.Error = fmt.Sprintf("unexpected status %d", resp.StatusCode)
+	}
+
+	return attempt
+}
+
+// apply updates a delivery with the outcome of an attempt. A failed delivery is retried after
+// the backoff until it runs out of attempts.
+func (d *Deliverer) apply(delivery *entity.Delivery, attempt entity.Attempt) {
+	delivery.Attempts++
+	delivery.LastAttemptAt = null.TimeFrom(attempt.At)
+	delivery.LastStatusCode = null.NewInt(int64(attempt.StatusCode), attempt.StatusCode != 0)
+	delivery.LastError = attempt.Error
+
+	switch {
+	case attempt.Succeeded():
+		delivery.Status = entity.DeliveryStatusSucceeded
+		delivery.DeliveredAt = null.TimeFrom(attempt.At)
+		delivery.NextAttemptAt = null.Time{}
+	case delivery.Attempts >= d.maxAttempts:
+		delivery.Status = entity.DeliveryStatusFailed
+		delivery.NextAttemptAt = null.Time{}
+	default:
+		delivery.NextAttemptAt = null.TimeFrom(attempt.At.Add(d.retryDelay(delivery.Attempts)))
+	}
+}
+
+// retryDelay returns the delay before the next attempt after the given number of attempts,
+// doubling with every attempt up to the maximum backoff.
+func (d *Deliverer) retryDelay(attempts int) time.Duration {
+	delay := d.retryBackoff
+	for i := 1; i < attempts && delay < d.maxRetryBackoff; i++ {
+		delay *= 2
+	}
+
+	return d.jitter(min(delay, d.maxRetryBackoff))
+}
+
+// equalJitter returns a random delay between half the given delay and the delay itself, which
+// spreads retries of endpoints that failed together.
+func equalJitter(delay time.Duration) time.Duration {
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+
+	return half + rand.N(delay-half+1)
+}
+
+// sign returns the signature of a payload sent at the given unix timestamp: the hex encoded
+// HMAC-SHA256 of the timestamp and the payload joined by a dot, keyed with the secret.
+func sign(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return signatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
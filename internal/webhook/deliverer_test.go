@@ -0,0 +1,226 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/safayildirim/wallet-management-service/internal/webhook/entity"
+	webhookmock "github.com/safayildirim/wallet-management-service/internal/webhook/mock"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDeliverer_Deliver(t *testing.T) {
+	payload := json.RawMessage(`{"id":42,"type":"WalletCreated"}`)
+
+	tests := []struct {
+		name                   string
+		responseStatus         int
+		attempts               int
+		expectedStatus         string
+		expectedSucceeded      bool
+		expectedError          string
+		expectedNextAttemptSet bool
+	}{
+		{
+			name:              "when endpoint accepts the delivery then should mark it succeeded",
+			responseStatus:    http.StatusNoContent,
+			expectedStatus:    entity.DeliveryStatusSucceeded,
+			expectedSucceeded: true,
+		},
+		{
+			name:                   "when endpoint rejects the delivery then should schedule a retry",
+			responseStatus:         http.StatusInternalServerError,
+			expectedStatus:         entity.DeliveryStatusPending,
+			expectedError:          "unexpected status 500",
+			expectedNextAttemptSet: true,
+		},
+		{
+			name:           "when last attempt is rejected then should mark the delivery failed",
+			responseStatus: http.StatusGone,
+			attempts:       2,
+			expectedStatus: entity.DeliveryStatusFailed,
+			expectedError:  "unexpected status 410",
+		},
+		{
+			name:                   "when endpoint redirects then should not follow the redirect",
+			responseStatus:         http.StatusFound,
+			expectedStatus:         entity.DeliveryStatusPending,
+			expectedError:          "unexpected status 302",
+			expectedNextAttemptSet: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received *http.Request
+			var body []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r
+				body, _ = io.ReadAll(r.Body)
+				w.Header().Set("Location", "/redirected")
+				w.WriteHeader(tt.responseStatus)
+			}))
+			defer server.Close()
+
+			mockRepository := webhookmock.NewMockWebhookRepository(t)
+			d, err := NewDeliverer(mockRepository, config.WebhookConfig{
+				DeliveryInterval:     time.Second,
+				DeliveryBatchSize:    2,
+				DeliveryTimeout:      time.Second,
+				MaxAttempts:          3,
+				RetryBackoff:         time.Minute,
+				MaxRetryBackoff:      time.Hour,
+				DisableAfterFailures: 5,
+			})
+			assert.NoError(t, err)
+			// The test server listens on loopback, which deliveries may not reach otherwise.
+			d.client = newClient(time.Second, func(netip.Addr) bool { return true })
+
+			delivery := &entity.Delivery{
+				ID:           1,
+				EventID:      42,
+				EventType:    "WalletCreated",
+				Payload:      payload,
+				Status:       entity.DeliveryStatusPending,
+				Attempts:     tt.attempts,
+				Subscription: &entity.Subscription{ID: 1, URL: server.URL, Secret: "0123456789abcdef"},
+			}
+			mockRepository.EXPECT().ClaimDeliveries(mock.Anything, mock.Anything, 2*time.Second, 2).
+				Return([]*entity.Delivery{delivery}, nil).Once()
+			mockRepository.EXPECT().RecordAttempt(mock.Anything, delivery, tt.expectedSucceeded, 5).Return(nil).Once()
+
+			total, err := d.deliver(context.Background())
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, total)
+
+			assert.Equal(t, "/", received.URL.Path)
+			assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+			assert.Equal(t, "42", received.Header.Get(HeaderWebhookID))
+			assert.Equal(t, "WalletCreated", received.Header.Get(HeaderWebhookEvent))
+			mac := hmac.New(sha256.New, []byte("0123456789abcdef"))
+			mac.Write([]byte(received.Header.Get(HeaderWebhookTimestamp) + "." + string(payload)))
+			assert.Equal(t, "v1="+hex.EncodeToString(mac.Sum(nil)), received.Header.Get(HeaderWebhookSignature))
+			assert.JSONEq(t, string(payload), string(body))
+
+			assert.Equal(t, tt.expectedStatus, delivery.Status)
+			assert.Equal(t, tt.attempts+1, delivery.Attempts)
+			assert.Equal(t, int64(tt.responseStatus), delivery.LastStatusCode.Int64)
+			assert.Equal(t, tt.expectedError, delivery.LastError)
+			assert.Equal(t, tt.expectedSucceeded, delivery.DeliveredAt.Valid)
+			assert.Equal(t, tt.expectedNextAttemptSet, delivery.NextAttemptAt.Valid)
+		})
+	}
+}
+
+func TestDeliverer_ForbiddenAddress(t *testing.T) {
+	var reached bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+	}))
+	defer server.Close()
+
+	mockRepository := webhookmock.NewMockWebhookRepository(t)
+	d, err := NewDeliverer(mockRepository, config.WebhookConfig{
+		DeliveryInterval:     time.Second,
+		DeliveryBatchSize:    2,
+		DeliveryTimeout:      time.Second,
+		MaxAttempts:          3,
+		RetryBackoff:         time.Minute,
+		MaxRetryBackoff:      time.Hour,
+		DisableAfterFailures: 5,
+	})
+	assert.NoError(t, err)
+
+	// A host name is resolved only when dialing, where the address it resolves to is checked.
+	delivery := &entity.Delivery{
+		ID:           1,
+		EventID:      42,
+		EventType:    "WalletCreated",
+		Payload:      json.RawMessage(`{"id":42}`),
+		Status:       entity.DeliveryStatusPending,
+		Subscription: &entity.Subscription{ID: 1, URL: strings.Replace(server.URL, "127.0.0.1", "localhost", 1)},
+	}
+	mockRepository.EXPECT().ClaimDeliveries(mock.Anything, mock.Anything, 2*time.Second, 2).
+		Return([]*entity.Delivery{delivery}, nil).Once()
+	mockRepository.EXPECT().RecordAttempt(mock.Anything, delivery, false, 5).Return(nil).Once()
+
+	total, err := d.deliver(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.False(t, reached)
+	assert.Contains(t, delivery.LastError, ErrForbiddenAddress.Error())
+	assert.False(t, delivery.LastStatusCode.Valid)
+	assert.True(t, delivery.NextAttemptAt.Valid)
+}
+
+func TestDeliverer_Apply(t *testing.T) {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := &Deliverer{
+		maxAttempts:     10,
+		retryBackoff:    time.Minute,
+		maxRetryBackoff: 5 * time.Minute,
+		jitter:          func(delay time.Duration) time.Duration { return delay },
+	}
+
+	tests := []struct {
+		name              string
+		attempts          int
+		attempt           entity.Attempt
+		expectedNext      time.Time
+		expectedCodeValid bool
+	}{
+		{
+			name:              "when first attempt fails then should retry after the base backoff",
+			attempt:           entity.Attempt{At: at, StatusCode: http.StatusBadGateway, Error: "unexpected status 502"},
+			expectedNext:      at.Add(time.Minute),
+			expectedCodeValid: true,
+		},
+		{
+			name:              "when third attempt fails then should double the backoff twice",
+			attempts:          2,
+			attempt:           entity.Attempt{At: at, StatusCode: http.StatusBadGateway, Error: "unexpected status 502"},
+			expectedNext:      at.Add(4 * time.Minute),
+			expectedCodeValid: true,
+		},
+		{
+			name:         "when endpoint cannot be reached then should cap the backoff",
+			attempts:     6,
+			attempt:      entity.Attempt{At: at, Error: "connection refused"},
+			expectedNext: at.Add(5 * time.Minute),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delivery := &entity.Delivery{Status: entity.DeliveryStatusPending, Attempts: tt.attempts}
+
+			d.apply(delivery, tt.attempt)
+
+			assert.Equal(t, entity.DeliveryStatusPending, delivery.Status)
+			assert.Equal(t, tt.expectedNext, delivery.NextAttemptAt.Time)
+			assert.Equal(t, tt.expectedCodeValid, delivery.LastStatusCode.Valid)
+			assert.Equal(t, tt.attempt.Error, delivery.LastError)
+		})
+	}
+}
+
+func TestEqualJitter(t *testing.T) {
+	for range 100 {
+		delay := equalJitter(time.Minute)
+		assert.GreaterOrEqual(t, delay, 30*time.Second)
+		assert.LessOrEqual(t, delay, time.Minute)
+	}
+}
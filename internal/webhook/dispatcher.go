@@ -0,0 +1,117 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	outboxentity "github.com/safayildirim/wallet-management-service/internal/outbox/entity"
+	"github.com/safayildirim/wallet-management-service/internal/webhook/entity"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/safayildirim/wallet-management-service/pkg/logger"
+	"github.com/safayildirim/wallet-management-service/pkg/worker"
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+// Dispatcher periodically turns the events recorded in the outbox into deliveries to the
+// subscriptions they match. It runs independently of the outbox relay, so a Kafka outage does
+// not hold webhooks back and vice versa.
+type Dispatcher struct {
+	webhookRepository Repository
+	interval          time.Duration
+	batchSize         int
+}
+
+func NewDispatcher(webhookRepository Repository, conf config.WebhookConfig) (*Dispatcher, error) {
+	if err := worker.Validate(conf.DispatchInterval, conf.DispatchBatchSize); err != nil {
+		return nil, errors.Wrap(err, "invalid webhook dispatcher config")
+	}
+
+	return &Dispatcher{
+		webhookRepository: webhookRepository,
+		interval:          conf.DispatchInterval,
+		batchSize:         conf.DispatchBatchSize,
+	}, nil
+}
+
+// Run dispatches pending events on every tick until the context is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		dispatched, err := d.dispatch(ctx)
+		if err != nil {
+			logger.Zap.Sugar().Errorf("webhook dispatch failed: %v", err)
+		} else if dispatched > 0 {
+			logger.Zap.Sugar().Debugf("dispatched %d events to webhooks", dispatched)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatch dispatches pending events in batches, each in its own transaction.
+func (d *Dispatcher) dispatch(ctx context.Context) (int, error) {
+	var total int
+	for {
+		dispatched, err := d.webhookRepository.DispatchEvents(ctx, d.batchSize, fanout)
+		if err != nil {
+			return total, err
+		}
+
+		total += dispatched
+		if dispatched < d.batchSize || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
+
+// fanout returns a delivery, due right away, of every event to every subscription it matches.
+func fanout(events []*outboxentity.Event, subscriptions []*entity.Subscription) []*entity.Delivery {
+	now := time.Now()
+	deliveries := make([]*entity.Delivery, 0)
+	for _, event := range events {
+		// Events without an owner only reach the subscriptions to every owner.
+		var aggregate struct {
+			OwnerID string `json:"owner_id"`
+		}
+		_ = json.Unmarshal(event.Payload, &aggregate)
+
+		var payload []byte
+		for _, subscription := range subscriptions {
+			if !subscription.Matches(event.Type, aggregate.OwnerID) {
+				continue
+			}
+
+			if payload == nil {
+				var err error
+				payload, err = json.Marshal(entity.Envelope{
+					ID:        event.ID,
+					Type:      event.Type,
+					CreatedAt: event.CreatedAt,
+					Data:      event.Payload,
+				})
+				if err != nil {
+					logger.Zap.Sugar().Errorf("skipping webhooks of outbox event %d: %v", event.ID, err)
+					break
+				}
+			}
+
+			deliveries = append(deliveries, &entity.Delivery{
+				SubscriptionID: subscription.ID,
+				EventID:        event.ID,
+				EventType:      event.Type,
+				Payload:        payload,
+				Status:         entity.DeliveryStatusPending,
+				NextAttemptAt:  null.TimeFrom(now),
+			})
+		}
+	}
+
+	return deliveries
+}
@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	outboxentity "github.com/safayildirim/wallet-management-service/internal/outbox/entity"
+	"github.com/safayildirim/wallet-management-service/internal/webhook/entity"
+	webhookmock "github.com/safayildirim/wallet-management-service/internal/webhook/mock"
+	"github.com/safayildirim/wallet-management-service/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+)
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	tests := []struct {
+		name          string
+		batches       []int
+		mockError     error
+		expectedTotal int
+		expectErr     bool
+	}{
+		{
+			name:          "when backlog spans several batches then should keep dispatching until a partial batch",
+			batches:       []int{2, 2, 1},
+			expectedTotal: 5,
+		},
+		{
+			name:          "when nothing is pending then should dispatch nothing",
+			batches:       []int{0},
+			expectedTotal: 0,
+		},
+		{
+			name:          "when repository returns an error then should stop and return error",
+			batches:       []int{2},
+			mockError:     errors.New("repository error"),
+			expectedTotal: 2,
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := webhookmock.NewMockWebhookRepository(t)
+			d, err := NewDispatcher(mockRepository, config.WebhookConfig{DispatchInterval: time.Second, DispatchBatchSize: 2})
+			assert.NoError(t, err)
+
+			for _, dispatched := range tt.batches {
+				mockRepository.EXPECT().DispatchEvents(context.Background(), 2, mock.Anything).Return(dispatched, nil).Once()
+			}
+			if tt.mockError != nil {
+				mockRepository.EXPECT().DispatchEvents(context.Background(), 2, mock.Anything).Return(0, tt.mockError).Once()
+			}
+
+			total, err := d.dispatch(context.Background())
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedTotal, total)
+		})
+	}
+}
+
+func TestFanout(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []*outboxentity.Event{
+		{ID: 1, CreatedAt: createdAt, Type: "WalletCreated", Payload: json.RawMessage(`{"id":1,"owner_id":"owner1"}`)},
+		{ID: 2, CreatedAt: createdAt, Type: "WalletDeleted", Payload: json.RawMessage(`{"id":2,"owner_id":"owner2"}`)},
+	}
+	subscriptions := []*entity.Subscription{
+		{ID: 10},
+		{ID: 11, OwnerID: "owner1"},
+		{ID: 12, EventTypes: entity.EventTypes{"WalletDeleted"}},
+		{ID: 13, OwnerID: "owner2", EventTypes: entity.EventTypes{"WalletCreated"}},
+	}
+
+	deliveries := fanout(events, subscriptions)
+
+	type target struct {
+		EventID        uint
+		SubscriptionID uint
+	}
+	targets := make([]target, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		targets = append(targets, target{EventID: delivery.EventID, SubscriptionID: delivery.SubscriptionID})
+		assert.Equal(t, entity.DeliveryStatusPending, delivery.Status)
+		assert.True(t, delivery.NextAttemptAt.Valid)
+	}
+	assert.Equal(t, []target{{1, 10}, {1, 11}, {2, 10}, {2, 12}}, targets)
+	assert.JSONEq(t,
+		`{"id":1,"type":"WalletCreated","created_at":"2024-01-01T00:00:00Z","data":{"id":1,"owner_id":"owner1"}}`,
+		string(deliveries[0].Payload),
+	)
+}
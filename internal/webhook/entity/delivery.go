@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"encoding/json"
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+const (
+	DeliveryStatusPending   = "pending"
+	DeliveryStatusSucceeded = "succeeded"
+	DeliveryStatusFailed    = "failed"
+)
+
+// Delivery is the notification of a subscription of a single event, attempted until the
+// endpoint accepts it or the attempts run out. Together, the deliveries of a subscription make
+// up its delivery log.
+type Delivery struct {
+	ID             uint            `json:"id"`
+	CreatedAt      time.Time       `json:"created_at"`
+	SubscriptionID uint            `json:"subscription_id"`
+	EventID        uint            `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload" gorm:"serializer:json"`
+	Status         string          `json:"status" gorm:"default:pending"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  null.Time       `json:"next_attempt_at"`
+	LastAttemptAt  null.Time       `json:"last_attempt_at"`
+	LastStatusCode null.Int        `json:"last_status_code"`
+	LastError      string          `json:"last_error"`
+	DeliveredAt    null.Time       `json:"delivered_at"`
+	Subscription   *Subscription   `json:"-" gorm:"foreignKey:SubscriptionID"`
+}
+
+func (Delivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// Envelope is the body posted to the endpoint of a subscription. Its ID is the ID of the event,
+// which stays the same across retries and redeliveries.
+type Envelope struct {
+	ID        uint            `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Attempt is the outcome of a single attempt to deliver. An attempt that got no response has a
+// zero status code and an error.
+type Attempt struct {
+	At         time.Time
+	StatusCode int
+	Error      string
+}
+
+// Succeeded reports whether the endpoint accepted the delivery.
+func (a Attempt) Succeeded() bool {
+	return a.Error == "" && a.StatusCode >= 200 && a.StatusCode < 300
+}
+
+// DeliveryFilter describes a single page of the delivery log of a subscription, newest first.
+type DeliveryFilter struct {
+	SubscriptionID uint
+	Status         string
+	Limit          int
+	BeforeID       uint
+}
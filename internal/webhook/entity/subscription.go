@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"github.com/lib/pq"
+	"gopkg.in/guregu/null.v3"
+	"slices"
+	"time"
+)
+
+// Subscription is a partner endpoint notified of wallet events. A subscription without an owner
+// is notified of the events of every wallet, one with an owner only of the events of that
+// owner's wallets.
+type Subscription struct {
+	ID         uint       `json:"id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  null.Time  `json:"updated_at"`
+	OwnerID    string     `json:"owner_id"`
+	URL        string     `json:"url"`
+	EventTypes EventTypes `json:"event_types" gorm:"type:text[]"`
+	Secret     string     `json:"secret,omitempty"`
+	Enabled    bool       `json:"enabled"`
+	// ConsecutiveFailures counts the failed attempts since the last successful delivery; the
+	// subscription is disabled once it reaches the configured limit.
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	DisabledAt          null.Time `json:"disabled_at"`
+}
+
+func (Subscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// Matches reports whether an event of the given type about a wallet of the given owner is to be
+// delivered to the subscription.
+func (s Subscription) Matches(eventType string, ownerID string) bool {
+	if s.OwnerID != "" && s.OwnerID != ownerID {
+		return false
+	}
+
+	return len(s.EventTypes) == 0 || slices.Contains(s.EventTypes, eventType)
+}
+
+// SubscriptionChanges holds the mutable fields of a subscription; nil fields are left untouched.
+type SubscriptionChanges struct {
+	URL        *string
+	EventTypes *EventTypes
+	Secret     *string
+	Enabled    *bool
+}
+
+// SubscriptionFilter narrows down a subscription listing.
+type SubscriptionFilter struct {
+	OwnerID *string
+}
+
+// EventTypes is the set of event types a subscription is notified of, stored in a text[]
+// column. An empty set stands for every event type.
+type EventTypes []string
+
+func (t EventTypes) Value() (driver.Value, error) {
+	if t == nil {
+		return "{}", nil
+	}
+
+	return pq.StringArray(t).Value()
+}
+
+func (t *EventTypes) Scan(value any) error {
+	var array pq.StringArray
+	if err := array.Scan(value); err != nil {
+		return err
+	}
+
+	*t = EventTypes(array)
+	return nil
+}
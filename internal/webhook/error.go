@@ -0,0 +1,9 @@
+package webhook
+
+import "github.com/safayildirim/wallet-management-service/internal/apperror"
+
+var (
+	ErrSubscriptionNotFound = apperror.NotFound("webhook_not_found", "webhook not found")
+	ErrDeliveryNotFound     = apperror.NotFound("webhook_delivery_not_found", "webhook delivery not found")
+	ErrInvalidCursor        = apperror.Validation("invalid_cursor", "invalid cursor")
+)
@@ -0,0 +1,215 @@
+package webhook
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/common"
+	"github.com/safayildirim/wallet-management-service/internal/webhook/request"
+	"net/http"
+)
+
+type Handler struct {
+	webhookService Service
+}
+
+func NewHandler(webhookService Service) *Handler {
+	return &Handler{webhookService: webhookService}
+}
+
+func (h Handler) RegisterRoutes(e *echo.Group) {
+	e.POST("/webhooks", h.CreateSubscription)
+	e.GET("/webhooks", h.ListSubscriptions)
+	e.GET("/webhooks/:id", h.GetSubscription)
+	e.PATCH("/webhooks/:id", h.UpdateSubscription)
+	e.DELETE("/webhooks/:id", h.DeleteSubscription)
+	e.GET("/webhooks/:id/deliveries", h.ListDeliveries)
+	e.POST("/webhooks/:id/deliveries/:delivery_id/redeliver", h.Redeliver)
+}
+
+// CreateSubscription subscribes an endpoint to wallet events.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 201 Created with the subscription, including its signing secret, on success.
+//   - 400 Bad Request if the request payload is invalid.
+//   - 403 Forbidden if the caller may not subscribe to the events of the owner.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) CreateSubscription(ctx echo.Context) error {
+	var req request.CreateSubscriptionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	subscription, err := h.webhookService.CreateSubscription(ctx.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, Response{Data: subscription})
+}
+
+// ListSubscriptions retrieves the subscriptions visible to the caller.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the subscriptions on success.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) ListSubscriptions(ctx echo.Context) error {
+	subscriptions, err := h.webhookService.ListSubscriptions(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: subscriptions})
+}
+
+// GetSubscription retrieves a subscription by its unique ID.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the subscription on success.
+//   - 400 Bad Request if the ID is invalid.
+//   - 404 Not Found if the subscription does not exist.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) GetSubscription(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	subscription, err := h.webhookService.GetSubscription(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: subscription})
+}
+
+// UpdateSubscription partially updates a subscription by its unique ID.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the updated subscription on success.
+//   - 400 Bad Request if the ID or the request payload is invalid.
+//   - 404 Not Found if the subscription does not exist.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) UpdateSubscription(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	var req request.UpdateSubscriptionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	subscription, err := h.webhookService.UpdateSubscription(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: subscription})
+}
+
+// DeleteSubscription deletes a subscription and its delivery log by its unique ID.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 204 No Content on success.
+//   - 400 Bad Request if the ID is invalid.
+//   - 404 Not Found if the subscription does not exist.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) DeleteSubscription(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	err = h.webhookService.DeleteSubscription(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListDeliveries retrieves a page of the delivery log of a subscription, newest first.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 200 OK with the deliveries and the cursor of the next page on success.
+//   - 400 Bad Request if the ID, the query parameters or the cursor are invalid.
+//   - 404 Not Found if the subscription does not exist.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) ListDeliveries(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	var req request.ListDeliveriesRequest
+	if err := ctx.Bind(&req); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	if err := req.Validate(); err != nil {
+		return apperror.InvalidRequest(err)
+	}
+
+	deliveries, next, err := h.webhookService.ListDeliveries(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, Response{Data: deliveries, NextCursor: next})
+}
+
+// Redeliver queues the event of a delivery to be delivered to the subscription once more.
+//
+// Parameters:
+//   - ctx: The Echo context containing the HTTP request and response.
+//
+// Returns:
+//   - 202 Accepted with the new delivery on success.
+//   - 400 Bad Request if either ID is invalid.
+//   - 404 Not Found if the subscription or the delivery does not exist.
+//   - 500 Internal Server Error for unexpected issues.
+func (h Handler) Redeliver(ctx echo.Context) error {
+	id, err := common.ParseIntFromString[uint](ctx.Param("id"))
+	if err != nil {
+		return apperror.InvalidParam("id", err)
+	}
+
+	deliveryID, err := common.ParseIntFromString[uint](ctx.Param("delivery_id"))
+	if err != nil {
+		return apperror.InvalidParam("delivery_id", err)
+	}
+
+	delivery, err := h.webhookService.Redeliver(ctx.Request().Context(), id, deliveryID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusAccepted, Response{Data: delivery})
+}
@@ -0,0 +1,293 @@
+package webhook
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/apperror"
+	"github.com/safayildirim/wallet-management-service/internal/webhook/entity"
+	webhookmock "github.com/safayildirim/wallet-management-service/internal/webhook/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler_CreateSubscription(t *testing.T) {
+	e := echo.New()
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                 string
+		body                 string
+		mockService          bool
+		mockReturnData       *entity.Subscription
+		mockReturnErr        error
+		expectedStatus       int
+		expectedBody         string
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:        "when request is valid then should return subscription with its secret",
+			body:        `{"url":"https://example.com/hooks","event_types":["WalletCreated"]}`,
+			mockService: true,
+			mockReturnData: &entity.Subscription{
+				ID: 1, CreatedAt: createdAt, URL: "https://example.com/hooks",
+				EventTypes: entity.EventTypes{"WalletCreated"}, Secret: "0123456789abcdef", Enabled: true,
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody: `{"data":{"id":1,"created_at":"2024-01-01T00:00:00Z","updated_at":null,"owner_id":"",
+				"url":"https://example.com/hooks","event_types":["WalletCreated"],"secret":"0123456789abcdef",
+				"enabled":true,"consecutive_failures":0,"disabled_at":null}}`,
+		},
+		{
+			name:                 "when url is not an http url then should return bad request",
+			body:                 `{"url":"ftp://example.com/hooks"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "url: must be an http or https URL",
+		},
+		{
+			name:                 "when url points to the metadata address then should return bad request",
+			body:                 `{"url":"http://169.254.169.254/latest/meta-data"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "url: must not point to a local or private host",
+		},
+		{
+			name:                 "when url points to a private address then should return bad request",
+			body:                 `{"url":"http://10.0.0.8:8080/hooks"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "url: must not point to a local or private host",
+		},
+		{
+			name:                 "when url points to localhost then should return bad request",
+			body:                 `{"url":"http://localhost:8080/hooks"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "url: must not point to a local or private host",
+		},
+		{
+			name:                 "when url points to an ipv6 loopback address then should return bad request",
+			body:                 `{"url":"http://[::1]/hooks"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "url: must not point to a local or private host",
+		},
+		{
+			name:                 "when event type is unknown then should return bad request",
+			body:                 `{"url":"https://example.com/hooks","event_types":["WalletExploded"]}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "event_types",
+		},
+		{
+			name:                 "when secret is too short then should return bad request",
+			body:                 `{"url":"https://example.com/hooks","secret":"short"}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "secret: the length must be between 16 and 256",
+		},
+		{
+			name:                 "when service returns error then should return internal server error",
+			body:                 `{"url":"https://example.com/hooks"}`,
+			mockService:          true,
+			mockReturnErr:        errors.New("service error"),
+			expectedStatus:       http.StatusInternalServerError,
+			expectErr:            true,
+			expectedErrorMessage: "service error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := webhookmock.NewMockWebhookService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				mockService.EXPECT().CreateSubscription(mock.Anything, mock.Anything).
+					Return(tt.mockReturnData, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.CreateSubscription(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_UpdateSubscription(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		id                   string
+		body                 string
+		mockService          bool
+		mockReturnErr        error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when subscription is re-enabled then should return subscription",
+			id:             "1",
+			body:           `{"enabled":true}`,
+			mockService:    true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "when event types are cleared then should return subscription",
+			id:             "1",
+			body:           `{"event_types":[]}`,
+			mockService:    true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "when no field is given then should return bad request",
+			id:                   "1",
+			body:                 `{}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "at least one field must be provided",
+		},
+		{
+			name:                 "when id is invalid then should return bad request",
+			id:                   "not-integer",
+			body:                 `{"enabled":true}`,
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "invalid syntax",
+		},
+		{
+			name:                 "when subscription does not exist then should return not found",
+			id:                   "1",
+			body:                 `{"enabled":false}`,
+			mockService:          true,
+			mockReturnErr:        ErrSubscriptionNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "webhook not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := webhookmock.NewMockWebhookService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				var subscription *entity.Subscription
+				if tt.mockReturnErr == nil {
+					subscription = &entity.Subscription{ID: 1, URL: "https://example.com/hooks", Enabled: true}
+				}
+				mockService.EXPECT().UpdateSubscription(mock.Anything, uint(1), mock.Anything).
+					Return(subscription, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/webhooks/:id", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.id)
+
+			err := handler.UpdateSubscription(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestHandler_Redeliver(t *testing.T) {
+	e := echo.New()
+
+	tests := []struct {
+		name                 string
+		deliveryID           string
+		mockService          bool
+		mockReturnErr        error
+		expectedStatus       int
+		expectErr            bool
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when delivery exists then should return accepted",
+			deliveryID:     "5",
+			mockService:    true,
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:                 "when delivery id is invalid then should return bad request",
+			deliveryID:           "not-integer",
+			expectedStatus:       http.StatusBadRequest,
+			expectErr:            true,
+			expectedErrorMessage: "invalid syntax",
+		},
+		{
+			name:                 "when delivery does not exist then should return not found",
+			deliveryID:           "5",
+			mockService:          true,
+			mockReturnErr:        ErrDeliveryNotFound,
+			expectedStatus:       http.StatusNotFound,
+			expectErr:            true,
+			expectedErrorMessage: "webhook delivery not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := webhookmock.NewMockWebhookService(t)
+			handler := NewHandler(mockService)
+
+			if tt.mockService {
+				var delivery *entity.Delivery
+				if tt.mockReturnErr == nil {
+					delivery = &entity.Delivery{ID: 6, SubscriptionID: 1, EventID: 42, Status: entity.DeliveryStatusPending}
+				}
+				mockService.EXPECT().Redeliver(mock.Anything, uint(1), uint(5)).Return(delivery, tt.mockReturnErr).Once()
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/:id/deliveries/:delivery_id/redeliver", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "delivery_id")
+			ctx.SetParamValues("1", tt.deliveryID)
+
+			err := handler.Redeliver(ctx)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErrorMessage)
+				assert.Equal(t, tt.expectedStatus, apperror.StatusOf(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package webhook
+
+type Response struct {
+	Data       any    `json:"data"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
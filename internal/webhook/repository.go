@@ -0,0 +1,275 @@
+package webhook
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	outboxentity "github.com/safayildirim/wallet-management-service/internal/outbox/entity"
+	"github.com/safayildirim/wallet-management-service/internal/webhook/entity"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type Repository interface {
+	CreateSubscription(ctx context.Context, subscription *entity.Subscription) (*entity.Subscription, error)
+	GetSubscription(ctx context.Context, id uint) (*entity.Subscription, error)
+	ListSubscriptions(ctx context.Context, filter entity.SubscriptionFilter) ([]*entity.Subscription, error)
+	UpdateSubscription(ctx context.Context, id uint, changes entity.SubscriptionChanges) (*entity.Subscription, error)
+	DeleteSubscription(ctx context.Context, id uint) error
+	DispatchEvents(ctx context.Context, limit int, fanout func(events []*outboxentity.Event, subscriptions []*entity.Subscription) []*entity.Delivery) (int, error)
+	ClaimDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.Delivery, error)
+	RecordAttempt(ctx context.Context, delivery *entity.Delivery, succeeded bool, disableAfter int) error
+	ListDeliveries(ctx context.Context, filter entity.DeliveryFilter) ([]*entity.Delivery, error)
+	GetDelivery(ctx context.Context, subscriptionID, deliveryID uint) (*entity.Delivery, error)
+	CreateDelivery(ctx context.Context, delivery *entity.Delivery) (*entity.Delivery, error)
+}
+
+type repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) CreateSubscription(ctx context.Context, subscription *entity.Subscription) (*entity.Subscription, error) {
+	if err := r.db.WithContext(ctx).Create(subscription).Error; err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (r *repository) GetSubscription(ctx context.Context, id uint) (*entity.Subscription, error) {
+	var item entity.Subscription
+	err := r.db.WithContext(ctx).First(&item, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSubscriptionNotFound
+		}
+
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// ListSubscriptions returns the subscriptions matching the filter, ordered by ID.
+func (r *repository) ListSubscriptions(ctx context.Context, filter entity.SubscriptionFilter) ([]*entity.Subscription, error) {
+	query := r.db.WithContext(ctx).Model(&entity.Subscription{})
+	if filter.OwnerID != nil {
+		query = query.Where("owner_id = ?", *filter.OwnerID)
+	}
+
+	items := make([]*entity.Subscription, 0)
+	if err := query.Order("id").Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// UpdateSubscription applies the changes to the subscription. Enabling a subscription clears
+// its failure count, giving the endpoint a fresh start.
+func (r *repository) UpdateSubscription(ctx context.Context, id uint, changes entity.SubscriptionChanges) (*entity.Subscription, error) {
+	updates := map[string]interface{}{
+		"updated_at": time.Now(),
+	}
+	if changes.URL != nil {
+		updates["url"] = *changes.URL
+	}
+	if changes.EventTypes != nil {
+		updates["event_types"] = *changes.EventTypes
+	}
+	if changes.Secret != nil {
+		updates["secret"] = *changes.Secret
+	}
+	if changes.Enabled != nil {
+		updates["enabled"] = *changes.Enabled
+		if *changes.Enabled {
+			updates["consecutive_failures"] = 0
+			updates["disabled_at"] = nil
+		}
+	}
+
+	var item entity.Subscription
+	result := r.db.WithContext(ctx).Model(&item).Clauses(clause.Returning{}).
+		Where("id = ?", id).
+		Updates(updates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, ErrSubscriptionNotFound
+	}
+
+	return &item, nil
+}
+
+// DeleteSubscription deletes the subscription together with its delivery log.
+func (r *repository) DeleteSubscription(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Delete(&entity.Subscription{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrSubscriptionNotFound
+	}
+
+	return nil
+}
+
+// DispatchEvents turns up to limit outbox events not yet dispatched to webhooks into deliveries
+// to the enabled subscriptions, as decided by fanout, and marks the events as dispatched, all in
+// a single transaction. Every event is therefore dispatched exactly once. Events locked by
+// another dispatcher are skipped.
+func (r *repository) DispatchEvents(ctx context.Context, limit int, fanout func(events []*outboxentity.Event, subscriptions []*entity.Subscription) []*entity.Delivery) (int, error) {
+	var dispatched int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var events []*outboxentity.Event
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("webhooks_dispatched_at IS NULL").Order("id").Limit(limit).Find(&events).Error
+		if err != nil {
+			return err
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		var subscriptions []*entity.Subscription
+		if err := tx.Where("enabled").Order("id").Find(&subscriptions).Error; err != nil {
+			return err
+		}
+
+		if deliveries := fanout(events, subscriptions); len(deliveries) > 0 {
+			if err := tx.Omit("Subscription").Create(&deliveries).Error; err != nil {
+				return err
+			}
+		}
+
+		ids := make([]uint, 0, len(events))
+		for _, event := range events {
+			ids = append(ids, event.ID)
+		}
+
+		err = tx.Model(&outboxentity.Event{}).Where("id IN ?", ids).Update("webhooks_dispatched_at", time.Now()).Error
+		if err != nil {
+			return err
+		}
+
+		dispatched = len(events)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return dispatched, nil
+}
+
+// ClaimDeliveries returns up to limit pending deliveries of enabled subscriptions that are due,
+// together with their subscriptions, and postpones their next attempt by lease. A claimed
+// delivery is not handed out again until the lease runs out, so a delivery whose outcome is lost
+// along with the process that attempted it is attempted again.
+func (r *repository) ClaimDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.Delivery, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE webhook_deliveries SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT d.id FROM webhook_deliveries AS d
+			JOIN webhook_subscriptions AS s ON s.id = d.subscription_id
+			WHERE d.status = ? AND d.next_attempt_at <= ? AND s.enabled
+			ORDER BY d.next_attempt_at
+			LIMIT ?
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		RETURNING id`,
+		now.Add(lease), entity.DeliveryStatusPending, now, limit,
+	).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*entity.Delivery, 0, len(ids))
+	if len(ids) == 0 {
+		return items, nil
+	}
+
+	if err := r.db.WithContext(ctx).Preload("Subscription").Order("id").Find(&items, ids).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// RecordAttempt stores the outcome of an attempt to deliver, as already applied to the delivery,
+// and keeps count of the consecutive failures of its subscription. A subscription whose count
+// reaches disableAfter is disabled.
+func (r *repository) RecordAttempt(ctx context.Context, delivery *entity.Delivery, succeeded bool, disableAfter int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(delivery).Omit("Subscription").Select(
+			"status", "attempts", "next_attempt_at", "last_attempt_at", "last_status_code", "last_error", "delivered_at",
+		).Updates(delivery).Error
+		if err != nil {
+			return err
+		}
+
+		subscription := tx.Model(&entity.Subscription{}).Where("id = ?", delivery.SubscriptionID)
+		if succeeded {
+			return subscription.Update("consecutive_failures", 0).Error
+		}
+
+		return subscription.Updates(map[string]interface{}{
+			"consecutive_failures": gorm.Expr("consecutive_failures + 1"),
+			"enabled":              gorm.Expr("enabled AND consecutive_failures + 1 < ?", disableAfter),
+			"disabled_at": gorm.Expr(
+				"CASE WHEN enabled AND consecutive_failures + 1 >= ? THEN ? ELSE disabled_at END",
+				disableAfter, delivery.LastAttemptAt,
+			),
+		}).Error
+	})
+}
+
+// ListDeliveries returns a page of the delivery log of a subscription, newest first.
+func (r *repository) ListDeliveries(ctx context.Context, filter entity.DeliveryFilter) ([]*entity.Delivery, error) {
+	query := r.db.WithContext(ctx).Where("subscription_id = ?", filter.SubscriptionID)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.BeforeID != 0 {
+		query = query.Where("id < ?", filter.BeforeID)
+	}
+
+	items := make([]*entity.Delivery, 0)
+	if err := query.Order("id DESC").Limit(filter.Limit).Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *repository) GetDelivery(ctx context.Context, subscriptionID, deliveryID uint) (*entity.Delivery, error) {
+	var item entity.Delivery
+	err := r.db.WithContext(ctx).Where("id = ? AND subscription_id = ?", deliveryID, subscriptionID).Take(&item).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDeliveryNotFound
+		}
+
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (r *repository) CreateDelivery(ctx context.Context, delivery *entity.Delivery) (*entity.Delivery, error) {
+	if err := r.db.WithContext(ctx).Omit("Subscription").Create(delivery).Error; err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,155 @@
+package request
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+	walletentity "github.com/safayildirim/wallet-management-service/internal/wallet/entity"
+	"github.com/safayildirim/wallet-management-service/internal/webhook/entity"
+	"net/netip"
+	"net/url"
+	"strings"
+)
+
+const (
+	MaxURLLength    = 2048
+	MinSecretLength = 16
+	MaxSecretLength = 256
+
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+// nonPublicPrefixes are the special purpose ranges that netip.Addr does not classify itself:
+// "this network", shared address space (carrier-grade NAT and some cloud metadata services),
+// IETF protocol assignments, benchmarking, reserved and NAT64 ranges.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// EventTypes are the event types a subscription can filter on.
+var EventTypes = []interface{}{
+	walletentity.EventWalletCreated,
+	walletentity.EventWalletUpdated,
+	walletentity.EventWalletDeleted,
+}
+
+// CreateSubscriptionRequest subscribes an endpoint to wallet events. Without event types the
+// endpoint is notified of every event; without a secret one is generated.
+type CreateSubscriptionRequest struct {
+	OwnerID    string   `json:"owner_id"`
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	Secret     string   `json:"secret"`
+	Enabled    *bool    `json:"enabled"`
+}
+
+func (r CreateSubscriptionRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.OwnerID, validation.Length(0, 64)),
+		validation.Field(&r.URL, validation.Required, validation.Length(1, MaxURLLength), validation.By(httpURL)),
+		validation.Field(&r.EventTypes, validation.Each(validation.In(EventTypes...))),
+		validation.Field(&r.Secret, validation.Length(MinSecretLength, MaxSecretLength)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "webhook create validation error")
+}
+
+// UpdateSubscriptionRequest changes a subscription. Event types are left untouched when absent
+// and an empty list subscribes to every event type. Enabling a subscription that was disabled
+// after repeated failures resumes its pending deliveries.
+type UpdateSubscriptionRequest struct {
+	URL        *string  `json:"url"`
+	EventTypes []string `json:"event_types"`
+	Secret     *string  `json:"secret"`
+	Enabled    *bool    `json:"enabled"`
+}
+
+func (r UpdateSubscriptionRequest) Validate() error {
+	if r.URL == nil && r.EventTypes == nil && r.Secret == nil && r.Enabled == nil {
+		return errors.New("webhook update validation error: at least one field must be provided")
+	}
+
+	fields := []*validation.FieldRules{
+		validation.Field(&r.URL, validation.NilOrNotEmpty, validation.Length(1, MaxURLLength), validation.By(httpURL)),
+		validation.Field(&r.EventTypes, validation.Each(validation.In(EventTypes...))),
+		validation.Field(&r.Secret, validation.NilOrNotEmpty, validation.Length(MinSecretLength, MaxSecretLength)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "webhook update validation error")
+}
+
+// ListDeliveriesRequest retrieves a page of the delivery log of a subscription, newest first.
+type ListDeliveriesRequest struct {
+	Status string `json:"status" query:"status"`
+	Limit  int    `json:"limit" query:"limit"`
+	Cursor string `json:"cursor" query:"cursor"`
+}
+
+func (r ListDeliveriesRequest) Validate() error {
+	fields := []*validation.FieldRules{
+		validation.Field(&r.Status, validation.In(
+			entity.DeliveryStatusPending, entity.DeliveryStatusSucceeded, entity.DeliveryStatusFailed,
+		)),
+		validation.Field(&r.Limit, validation.Min(0), validation.Max(MaxListLimit)),
+	}
+
+	return errors.Wrap(validation.ValidateStruct(&r, fields...), "webhook delivery list validation error")
+}
+
+// IsPublicIP reports whether an IP address is publicly routable, and may therefore be the
+// endpoint of a subscription. Loopback, private, link-local (including the cloud metadata
+// address 169.254.169.254), multicast and unspecified addresses are not.
+func IsPublicIP(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// httpURL checks that a URL is an absolute http or https URL that does not point to a local or
+// private host. Host names are resolved only when delivering, where the resolved address is
+// checked again.
+func httpURL(value interface{}) error {
+	var raw string
+	switch v := value.(type) {
+	case string:
+		raw = v
+	case *string:
+		if v == nil {
+			return nil
+		}
+		raw = *v
+	}
+
+	if raw == "" {
+		return nil
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		return errors.New("must be an http or https URL")
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(parsed.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errors.New("must not point to a local or private host")
+	}
+	if ip, err := netip.ParseAddr(host); err == nil && !IsPublicIP(ip) {
+		return errors.New("must not point to a local or private host")
+	}
+
+	return nil
+}
@@ -0,0 +1,300 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/safayildirim/wallet-management-service/internal/auth"
+	"github.com/safayildirim/wallet-management-service/internal/common"
+	"github.com/safayildirim/wallet-management-service/internal/wallet"
+	"github.com/safayildirim/wallet-management-service/internal/webhook/entity"
+	"github.com/safayildirim/wallet-management-service/internal/webhook/request"
+	"gopkg.in/guregu/null.v3"
+	"time"
+)
+
+type Service interface {
+	CreateSubscription(ctx context.Context, request *request.CreateSubscriptionRequest) (*entity.Subscription, error)
+	GetSubscription(ctx context.Context, id uint) (*entity.Subscription, error)
+	ListSubscriptions(ctx context.Context) ([]*entity.Subscription, error)
+	UpdateSubscription(ctx context.Context, id uint, request *request.UpdateSubscriptionRequest) (*entity.Subscription, error)
+	DeleteSubscription(ctx context.Context, id uint) error
+	ListDeliveries(ctx context.Context, id uint, request *request.ListDeliveriesRequest) ([]*entity.Delivery, string, error)
+	Redeliver(ctx context.Context, id uint, deliveryID uint) (*entity.Delivery, error)
+}
+
+const defaultListLimit = request.DefaultListLimit
+
+// secretSize is the number of random bytes of a generated secret.
+const secretSize = 32
+
+type service struct {
+	webhookRepository Repository
+}
+
+func NewService(webhookRepository Repository) Service {
+	return &service{webhookRepository: webhookRepository}
+}
+
+// CreateSubscription subscribes an endpoint to wallet events.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - request: Request object containing the owner, the URL, the event types and the secret.
+//
+// Returns:
+//   - The created subscription, the only time its secret is returned.
+//   - An error if the caller may not act for the owner, a secret cannot be generated or creation
+//     fails.
+func (s *service) CreateSubscription(ctx context.Context, request *request.CreateSubscriptionRequest) (*entity.Subscription, error) {
+	ownerID := request.OwnerID
+	if scope, ok := auth.ScopeFrom(ctx); ok {
+		// Scoped callers only ever subscribe to the events of their own wallets.
+		if ownerID == "" {
+			ownerID = scope.OwnerID
+		}
+		if !scope.Allows(ownerID) {
+			return nil, wallet.ErrOwnerForbidden
+		}
+	}
+
+	secret := request.Secret
+	if secret == "" {
+		var err error
+		if secret, err = generateSecret(); err != nil {
+			return nil, err
+		}
+	}
+
+	item := entity.Subscription{
+		OwnerID:    ownerID,
+		URL:        request.URL,
+		EventTypes: entity.EventTypes(request.EventTypes),
+		Secret:     secret,
+		Enabled:    true,
+	}
+	if request.Enabled != nil {
+		item.Enabled = *request.Enabled
+	}
+	if !item.Enabled {
+		item.DisabledAt = null.TimeFrom(time.Now())
+	}
+
+	return s.webhookRepository.CreateSubscription(ctx, &item)
+}
+
+// GetSubscription retrieves a subscription by its ID.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - id: The unique identifier of the subscription.
+//
+// Returns:
+//   - The subscription without its secret.
+//   - An error if the subscription does not exist, belongs to another owner or retrieval fails.
+func (s *service) GetSubscription(ctx context.Context, id uint) (*entity.Subscription, error) {
+	item, err := s.visibleSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return redact(item), nil
+}
+
+// ListSubscriptions returns the subscriptions the caller may see, ordered by ID.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//
+// Returns:
+//   - The subscriptions without their secrets.
+//   - An error if retrieval fails.
+func (s *service) ListSubscriptions(ctx context.Context) ([]*entity.Subscription, error) {
+	var filter entity.SubscriptionFilter
+	if scope, ok := auth.ScopeFrom(ctx); ok {
+		filter.OwnerID = &scope.OwnerID
+	}
+
+	items, err := s.webhookRepository.ListSubscriptions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		redact(item)
+	}
+
+	return items, nil
+}
+
+// UpdateSubscription partially updates a subscription.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - id: The unique identifier of the subscription.
+//   - request: Request object containing the fields to change.
+//
+// Returns:
+//   - The updated subscription without its secret.
+//   - An error if the subscription does not exist, belongs to another owner or the update fails.
+func (s *service) UpdateSubscription(ctx context.Context, id uint, request *request.UpdateSubscriptionRequest) (*entity.Subscription, error) {
+	if _, err := s.visibleSubscription(ctx, id); err != nil {
+		return nil, err
+	}
+
+	changes := entity.SubscriptionChanges{
+		URL:     request.URL,
+		Secret:  request.Secret,
+		Enabled: request.Enabled,
+	}
+	if request.EventTypes != nil {
+		eventTypes := entity.EventTypes(request.EventTypes)
+		changes.EventTypes = &eventTypes
+	}
+
+	item, err := s.webhookRepository.UpdateSubscription(ctx, id, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return redact(item), nil
+}
+
+// DeleteSubscription deletes a subscription together with its delivery log.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - id: The unique identifier of the subscription.
+//
+// Returns:
+//   - An error if the subscription does not exist, belongs to another owner or deletion fails.
+func (s *service) DeleteSubscription(ctx context.Context, id uint) error {
+	if _, err := s.visibleSubscription(ctx, id); err != nil {
+		return err
+	}
+
+	return s.webhookRepository.DeleteSubscription(ctx, id)
+}
+
+// ListDeliveries retrieves a page of the delivery log of a subscription, newest first.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - id: The unique identifier of the subscription.
+//   - request: Request object containing the status filter, the page size and the cursor.
+//
+// Returns:
+//   - The deliveries of the page.
+//   - The cursor of the next page, or an empty string on the last page.
+//   - An error if the cursor is invalid, the subscription does not exist, belongs to another
+//     owner or retrieval fails.
+func (s *service) ListDeliveries(ctx context.Context, id uint, request *request.ListDeliveriesRequest) ([]*entity.Delivery, string, error) {
+	filter := entity.DeliveryFilter{
+		SubscriptionID: id,
+		Status:         request.Status,
+		Limit:          request.Limit,
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultListLimit
+	}
+
+	if request.Cursor != "" {
+		var cursor deliveryCursor
+		if err := common.DecodeCursor(request.Cursor, &cursor); err != nil || cursor.ID == 0 {
+			return nil, "", ErrInvalidCursor
+		}
+		filter.BeforeID = cursor.ID
+	}
+
+	if _, err := s.visibleSubscription(ctx, id); err != nil {
+		return nil, "", err
+	}
+
+	// Fetch one extra row to find out whether there is a next page.
+	limit := filter.Limit
+	filter.Limit++
+
+	items, err := s.webhookRepository.ListDeliveries(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(items) <= limit {
+		return items, "", nil
+	}
+
+	items = items[:limit]
+	next, err := common.EncodeCursor(deliveryCursor{ID: items[len(items)-1].ID})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return items, next, nil
+}
+
+// Redeliver delivers the event of a delivery to the subscription once more, as a new delivery
+// that is attempted right away. The original delivery is left in the log as it is.
+//
+// Parameters:
+//   - ctx: Context for managing request lifecycle and cancellation.
+//   - id: The unique identifier of the subscription.
+//   - deliveryID: The unique identifier of the delivery to repeat.
+//
+// Returns:
+//   - The new delivery.
+//   - An error if the subscription or the delivery does not exist, the subscription belongs to
+//     another owner or creation fails.
+func (s *service) Redeliver(ctx context.Context, id uint, deliveryID uint) (*entity.Delivery, error) {
+	if _, err := s.visibleSubscription(ctx, id); err != nil {
+		return nil, err
+	}
+
+	original, err := s.webhookRepository.GetDelivery(ctx, id, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.webhookRepository.CreateDelivery(ctx, &entity.Delivery{
+		SubscriptionID: id,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+		Status:         entity.DeliveryStatusPending,
+		NextAttemptAt:  null.TimeFrom(time.Now()),
+	})
+}
+
+// visibleSubscription retrieves a subscription the caller may see.
+func (s *service) visibleSubscription(ctx context.Context, id uint) (*entity.Subscription, error) {
+	item, err := s.webhookRepository.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if scope, ok := auth.ScopeFrom(ctx); ok && !scope.Allows(item.OwnerID) {
+		return nil, ErrSubscriptionNotFound
+	}
+
+	return item, nil
+}
+
+// redact removes the secret of a subscription, which is only ever returned on creation.
+func redact(subscription *entity.Subscription) *entity.Subscription {
+	subscription.Secret = ""
+	return subscription
+}
+
+// generateSecret returns a random, hex encoded signing secret.
+func generateSecret() (string, error) {
+	raw := make([]byte, secretSize)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(raw), nil
+}
+
+// deliveryCursor is the decoded form of the opaque cursor returned by ListDeliveries.
+type deliveryCursor struct {
+	ID uint `json:"i"`
+}
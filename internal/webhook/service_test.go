@@ -0,0 +1,299 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"github.com/safayildirim/wallet-management-service/internal/auth"
+	"github.com/safayildirim/wallet-management-service/internal/common"
+	"github.com/safayildirim/wallet-management-service/internal/wallet"
+	"github.com/safayildirim/wallet-management-service/internal/webhook/entity"
+	webhookmock "github.com/safayildirim/wallet-management-service/internal/webhook/mock"
+	"github.com/safayildirim/wallet-management-service/internal/webhook/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestService_CreateSubscription(t *testing.T) {
+	enabled := false
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		request         *request.CreateSubscriptionRequest
+		mockRepository  bool
+		mockReturnErr   error
+		expectedOwnerID string
+		expectedSecret  string
+		expectedEnabled bool
+		expectedErr     error
+		expectErr       bool
+	}{
+		{
+			name:            "when secret is given then should create subscription with it",
+			ctx:             context.Background(),
+			request:         &request.CreateSubscriptionRequest{URL: "https://example.com/hooks", Secret: "0123456789abcdef"},
+			mockRepository:  true,
+			expectedSecret:  "0123456789abcdef",
+			expectedEnabled: true,
+		},
+		{
+			name:            "when secret is omitted then should generate one",
+			ctx:             context.Background(),
+			request:         &request.CreateSubscriptionRequest{URL: "https://example.com/hooks"},
+			mockRepository:  true,
+			expectedEnabled: true,
+		},
+		{
+			name:           "when subscription is created disabled then should record when it was disabled",
+			ctx:            context.Background(),
+			request:        &request.CreateSubscriptionRequest{URL: "https://example.com/hooks", Enabled: &enabled},
+			mockRepository: true,
+		},
+		{
+			name:            "when caller is scoped and owner is omitted then should subscribe to the caller's wallets",
+			ctx:             auth.WithScope(context.Background(), auth.Scope{OwnerID: "owner1"}),
+			request:         &request.CreateSubscriptionRequest{URL: "https://example.com/hooks"},
+			mockRepository:  true,
+			expectedOwnerID: "owner1",
+			expectedEnabled: true,
+		},
+		{
+			name:        "when caller is scoped to another owner then should return forbidden",
+			ctx:         auth.WithScope(context.Background(), auth.Scope{OwnerID: "owner1"}),
+			request:     &request.CreateSubscriptionRequest{OwnerID: "owner2", URL: "https://example.com/hooks"},
+			expectedErr: wallet.ErrOwnerForbidden,
+			expectErr:   true,
+		},
+		{
+			name:           "when repository returns an error then should return error",
+			ctx:            context.Background(),
+			request:        &request.CreateSubscriptionRequest{URL: "https://example.com/hooks"},
+			mockRepository: true,
+			mockReturnErr:  errors.New("repository error"),
+			expectErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := webhookmock.NewMockWebhookRepository(t)
+			s := NewService(mockRepository)
+
+			if tt.mockRepository {
+				mockRepository.EXPECT().CreateSubscription(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, subscription *entity.Subscription) (*entity.Subscription, error) {
+						if tt.mockReturnErr != nil {
+							return nil, tt.mockReturnErr
+						}
+						return subscription, nil
+					}).Once()
+			}
+
+			subscription, err := s.CreateSubscription(tt.ctx, tt.request)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, err, tt.expectedErr)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOwnerID, subscription.OwnerID)
+			assert.Equal(t, tt.expectedEnabled, subscription.Enabled)
+			assert.Equal(t, !tt.expectedEnabled, subscription.DisabledAt.Valid)
+			if tt.expectedSecret != "" {
+				assert.Equal(t, tt.expectedSecret, subscription.Secret)
+			} else {
+				assert.Len(t, subscription.Secret, 2*secretSize)
+			}
+		})
+	}
+}
+
+func TestService_GetSubscription(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		mockReturn    *entity.Subscription
+		mockReturnErr error
+		expectedErr   error
+	}{
+		{
+			name:       "when subscription exists then should return it without its secret",
+			ctx:        context.Background(),
+			mockReturn: &entity.Subscription{ID: 1, OwnerID: "owner1", Secret: "secret"},
+		},
+		{
+			name:       "when subscription belongs to the scoped owner then should return it",
+			ctx:        auth.WithScope(context.Background(), auth.Scope{OwnerID: "owner1"}),
+			mockReturn: &entity.Subscription{ID: 1, OwnerID: "owner1", Secret: "secret"},
+		},
+		{
+			name:        "when subscription belongs to another owner then should return not found",
+			ctx:         auth.WithScope(context.Background(), auth.Scope{OwnerID: "owner2"}),
+			mockReturn:  &entity.Subscription{ID: 1, OwnerID: "owner1", Secret: "secret"},
+			expectedErr: ErrSubscriptionNotFound,
+		},
+		{
+			name:          "when subscription does not exist then should return not found",
+			ctx:           context.Background(),
+			mockReturnErr: ErrSubscriptionNotFound,
+			expectedErr:   ErrSubscriptionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := webhookmock.NewMockWebhookRepository(t)
+			s := NewService(mockRepository)
+
+			mockRepository.EXPECT().GetSubscription(mock.Anything, uint(1)).Return(tt.mockReturn, tt.mockReturnErr).Once()
+
+			subscription, err := s.GetSubscription(tt.ctx, 1)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, subscription)
+			} else {
+				assert.NoError(t, err)
+				assert.Empty(t, subscription.Secret)
+			}
+		})
+	}
+}
+
+func TestService_ListDeliveries(t *testing.T) {
+	cursor, _ := common.EncodeCursor(deliveryCursor{ID: 10})
+
+	tests := []struct {
+		name               string
+		request            *request.ListDeliveriesRequest
+		mockRepository     bool
+		mockReturn         []*entity.Delivery
+		expectedFilter     entity.DeliveryFilter
+		expectedIDs        []uint
+		expectedNextCursor string
+		expectedErr        error
+	}{
+		{
+			name:           "when there are more deliveries than the limit then should return cursor of next page",
+			request:        &request.ListDeliveriesRequest{Limit: 2},
+			mockRepository: true,
+			mockReturn:     []*entity.Delivery{{ID: 12}, {ID: 11}, {ID: 10}},
+			expectedFilter: entity.DeliveryFilter{SubscriptionID: 1, Limit: 3},
+			expectedIDs:    []uint{12, 11},
+			expectedNextCursor: func() string {
+				next, _ := common.EncodeCursor(deliveryCursor{ID: 11})
+				return next
+			}(),
+		},
+		{
+			name:           "when cursor and status are given then should list older deliveries with that status",
+			request:        &request.ListDeliveriesRequest{Status: entity.DeliveryStatusFailed, Cursor: cursor},
+			mockRepository: true,
+			mockReturn:     []*entity.Delivery{{ID: 9}},
+			expectedFilter: entity.DeliveryFilter{SubscriptionID: 1, Status: entity.DeliveryStatusFailed, Limit: request.DefaultListLimit + 1, BeforeID: 10},
+			expectedIDs:    []uint{9},
+		},
+		{
+			name:        "when cursor is invalid then should return invalid cursor",
+			request:     &request.ListDeliveriesRequest{Cursor: "not-a-cursor"},
+			expectedErr: ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := webhookmock.NewMockWebhookRepository(t)
+			s := NewService(mockRepository)
+
+			if tt.mockRepository {
+				mockRepository.EXPECT().GetSubscription(mock.Anything, uint(1)).Return(&entity.Subscription{ID: 1}, nil).Once()
+				mockRepository.EXPECT().ListDeliveries(mock.Anything, tt.expectedFilter).Return(tt.mockReturn, nil).Once()
+			}
+
+			deliveries, next, err := s.ListDeliveries(context.Background(), 1, tt.request)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			ids := make([]uint, 0, len(deliveries))
+			for _, delivery := range deliveries {
+				ids = append(ids, delivery.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+			assert.Equal(t, tt.expectedNextCursor, next)
+		})
+	}
+}
+
+func TestService_Redeliver(t *testing.T) {
+	original := &entity.Delivery{
+		ID:             5,
+		SubscriptionID: 1,
+		EventID:        42,
+		EventType:      "WalletCreated",
+		Payload:        json.RawMessage(`{"id":42}`),
+		Status:         entity.DeliveryStatusFailed,
+		Attempts:       10,
+		LastError:      "unexpected status 500",
+	}
+
+	tests := []struct {
+		name           string
+		mockReturnErr  error
+		expectedErr    error
+		mockRepository bool
+	}{
+		{
+			name:           "when delivery exists then should queue a new delivery of its event",
+			mockRepository: true,
+		},
+		{
+			name:          "when delivery does not exist then should return not found",
+			mockReturnErr: ErrDeliveryNotFound,
+			expectedErr:   ErrDeliveryNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepository := webhookmock.NewMockWebhookRepository(t)
+			s := NewService(mockRepository)
+
+			mockRepository.EXPECT().GetSubscription(mock.Anything, uint(1)).Return(&entity.Subscription{ID: 1}, nil).Once()
+			if tt.mockReturnErr != nil {
+				mockRepository.EXPECT().GetDelivery(mock.Anything, uint(1), uint(5)).Return(nil, tt.mockReturnErr).Once()
+			} else {
+				mockRepository.EXPECT().GetDelivery(mock.Anything, uint(1), uint(5)).Return(original, nil).Once()
+			}
+			if tt.mockRepository {
+				mockRepository.EXPECT().CreateDelivery(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, delivery *entity.Delivery) (*entity.Delivery, error) {
+						return delivery, nil
+					}).Once()
+			}
+
+			delivery, err := s.Redeliver(context.Background(), 1, 5)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, uint(42), delivery.EventID)
+			assert.Equal(t, original.Payload, delivery.Payload)
+			assert.Equal(t, entity.DeliveryStatusPending, delivery.Status)
+			assert.Zero(t, delivery.Attempts)
+			assert.True(t, delivery.NextAttemptAt.Valid)
+		})
+	}
+}
@@ -2,12 +2,21 @@ package config
 
 import (
 	"github.com/safayildirim/wallet-management-service/pkg/env"
+	"time"
 )
 
 type Config struct {
-	App      AppConfig
-	Http     HttpConfig
-	Postgres PostgresConfig
+	App         AppConfig
+	Http        HttpConfig
+	Grpc        GrpcConfig
+	Postgres    PostgresConfig
+	Wallet      WalletConfig
+	Idempotency IdempotencyConfig
+	Ledger      LedgerConfig
+	Kafka       KafkaConfig
+	Outbox      OutboxConfig
+	Webhook     WebhookConfig
+	EventFeed   EventFeedConfig
 }
 
 var BaseConfig *Config
@@ -34,6 +43,70 @@ type HttpConfig struct {
 	Host string
 }
 
+type GrpcConfig struct {
+	Port int
+}
+
+type WalletConfig struct {
+	PurgeRetention time.Duration
+	PurgeInterval  time.Duration
+	PurgeBatchSize int
+}
+
+type LedgerConfig struct {
+	HoldTTL                 time.Duration
+	HoldMaxTTL              time.Duration
+	HoldExpiryInterval      time.Duration
+	HoldExpiryBatchSize     int
+	DepositRetryBackoff     time.Duration
+	ParkedDepositInterval   time.Duration
+	ParkedDepositBatchSize  int
+	ParkedDepositMaxBackoff time.Duration
+}
+
+type KafkaConfig struct {
+	Brokers                 []string
+	WalletEventsTopic       string
+	DepositsTopic           string
+	DepositsGroupID         string
+	DepositsDeadLetterTopic string
+}
+
+type OutboxConfig struct {
+	RelayInterval  time.Duration
+	RelayBatchSize int
+	PurgeRetention time.Duration
+	PurgeInterval  time.Duration
+	PurgeBatchSize int
+}
+
+type WebhookConfig struct {
+	DispatchInterval     time.Duration
+	DispatchBatchSize    int
+	DeliveryInterval     time.Duration
+	DeliveryBatchSize    int
+	DeliveryTimeout      time.Duration
+	MaxAttempts          int
+	RetryBackoff         time.Duration
+	MaxRetryBackoff      time.Duration
+	DisableAfterFailures int
+}
+
+type EventFeedConfig struct {
+	SequenceInterval  time.Duration
+	SequenceBatchSize int
+	PollInterval      time.Duration
+	HeartbeatInterval time.Duration
+}
+
+type IdempotencyConfig struct {
+	KeyTTL         time.Duration
+	LockTimeout    time.Duration
+	SweepInterval  time.Duration
+	SweepBatchSize int
+	MaxBodySize    int
+}
+
 func init() {
 	BaseConfig = New()
 }
@@ -48,6 +121,9 @@ func New() *Config {
 			Port: env.New("HTTP_PORT", "8080").AsInt(),
 			Host: env.New("HTTP_HOST", "localhost").AsString(),
 		},
+		Grpc: GrpcConfig{
+			Port: env.New("GRPC_PORT", "9090").AsInt(),
+		},
 		Postgres: PostgresConfig{
 			Host:            env.New("PG_HOST", nil).AsString(),
 			Port:            env.New("PG_PORT", nil).AsString(),
@@ -59,6 +135,59 @@ func New() *Config {
 			MaxLifeTimeConn: env.New("PG_MAX_LIFETIME_CONNECTIONS", "20").AsInt(),
 			SslMode:         env.New("PG_SSL_MODE", true).AsString(),
 		},
+		Wallet: WalletConfig{
+			PurgeRetention: env.New("WALLET_PURGE_RETENTION", "720h").AsDuration(),
+			PurgeInterval:  env.New("WALLET_PURGE_INTERVAL", "1h").AsDuration(),
+			PurgeBatchSize: env.New("WALLET_PURGE_BATCH_SIZE", "500").AsInt(),
+		},
+		Idempotency: IdempotencyConfig{
+			KeyTTL:         env.New("IDEMPOTENCY_KEY_TTL", "24h").AsDuration(),
+			LockTimeout:    env.New("IDEMPOTENCY_LOCK_TIMEOUT", "1m").AsDuration(),
+			SweepInterval:  env.New("IDEMPOTENCY_SWEEP_INTERVAL", "1h").AsDuration(),
+			SweepBatchSize: env.New("IDEMPOTENCY_SWEEP_BATCH_SIZE", "500").AsInt(),
+			MaxBodySize:    env.New("IDEMPOTENCY_MAX_BODY_SIZE", "1048576").AsInt(),
+		},
+		Ledger: LedgerConfig{
+			HoldTTL:                 env.New("LEDGER_HOLD_TTL", "15m").AsDuration(),
+			HoldMaxTTL:              env.New("LEDGER_HOLD_MAX_TTL", "720h").AsDuration(),
+			HoldExpiryInterval:      env.New("LEDGER_HOLD_EXPIRY_INTERVAL", "1m").AsDuration(),
+			HoldExpiryBatchSize:     env.New("LEDGER_HOLD_EXPIRY_BATCH_SIZE", "100").AsInt(),
+			DepositRetryBackoff:     env.New("LEDGER_DEPOSIT_RETRY_BACKOFF", "1s").AsDuration(),
+			ParkedDepositInterval:   env.New("LEDGER_PARKED_DEPOSIT_INTERVAL", "1m").AsDuration(),
+			ParkedDepositBatchSize:  env.New("LEDGER_PARKED_DEPOSIT_BATCH_SIZE", "100").AsInt(),
+			ParkedDepositMaxBackoff: env.New("LEDGER_PARKED_DEPOSIT_MAX_BACKOFF", "1h").AsDuration(),
+		},
+		Kafka: KafkaConfig{
+			Brokers:                 env.New("KAFKA_BROKERS", "localhost:9092").AsStringSlice(","),
+			WalletEventsTopic:       env.New("KAFKA_WALLET_EVENTS_TOPIC", "wallet-events").AsString(),
+			DepositsTopic:           env.New("KAFKA_DEPOSITS_TOPIC", "chain-deposits").AsString(),
+			DepositsGroupID:         env.New("KAFKA_DEPOSITS_GROUP_ID", "wallet-management-service").AsString(),
+			DepositsDeadLetterTopic: env.New("KAFKA_DEPOSITS_DEAD_LETTER_TOPIC", "chain-deposits-dlq").AsString(),
+		},
+		Outbox: OutboxConfig{
+			RelayInterval:  env.New("OUTBOX_RELAY_INTERVAL", "1s").AsDuration(),
+			RelayBatchSize: env.New("OUTBOX_RELAY_BATCH_SIZE", "100").AsInt(),
+			PurgeRetention: env.New("OUTBOX_PURGE_RETENTION", "720h").AsDuration(),
+			PurgeInterval:  env.New("OUTBOX_PURGE_INTERVAL", "1h").AsDuration(),
+			PurgeBatchSize: env.New("OUTBOX_PURGE_BATCH_SIZE", "500").AsInt(),
+		},
+		Webhook: WebhookConfig{
+			DispatchInterval:     env.New("WEBHOOK_DISPATCH_INTERVAL", "1s").AsDuration(),
+			DispatchBatchSize:    env.New("WEBHOOK_DISPATCH_BATCH_SIZE", "100").AsInt(),
+			DeliveryInterval:     env.New("WEBHOOK_DELIVERY_INTERVAL", "1s").AsDuration(),
+			DeliveryBatchSize:    env.New("WEBHOOK_DELIVERY_BATCH_SIZE", "20").AsInt(),
+			DeliveryTimeout:      env.New("WEBHOOK_DELIVERY_TIMEOUT", "10s").AsDuration(),
+			MaxAttempts:          env.New("WEBHOOK_MAX_ATTEMPTS", "10").AsInt(),
+			RetryBackoff:         env.New("WEBHOOK_RETRY_BACKOFF", "30s").AsDuration(),
+			MaxRetryBackoff:      env.New("WEBHOOK_MAX_RETRY_BACKOFF", "6h").AsDuration(),
+			DisableAfterFailures: env.New("WEBHOOK_DISABLE_AFTER_FAILURES", "20").AsInt(),
+		},
+		EventFeed: EventFeedConfig{
+			SequenceInterval:  env.New("EVENT_FEED_SEQUENCE_INTERVAL", "200ms").AsDuration(),
+			SequenceBatchSize: env.New("EVENT_FEED_SEQUENCE_BATCH_SIZE", "500").AsInt(),
+			PollInterval:      env.New("EVENT_FEED_POLL_INTERVAL", "500ms").AsDuration(),
+			HeartbeatInterval: env.New("EVENT_FEED_HEARTBEAT_INTERVAL", "15s").AsDuration(),
+		},
 	}
 }
 
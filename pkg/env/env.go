@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -82,6 +83,15 @@ func (eVar EVar) AsBool() bool {
 	return val
 }
 
+func (eVar EVar) AsDuration() time.Duration {
+	val, err := time.ParseDuration(eVar.AsString())
+	if err != nil {
+		log.Fatalf("could not convert eVar to duration %v", eVar.key)
+	}
+
+	return val
+}
+
 func (eVar EVar) AsStringSlice(sep string) []string {
 	valStr := eVar.AsString()
 
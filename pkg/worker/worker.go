@@ -0,0 +1,26 @@
+package worker
+
+import (
+	"github.com/pkg/errors"
+	"time"
+)
+
+var (
+	ErrInvalidInterval  = errors.New("interval must be positive")
+	ErrInvalidBatchSize = errors.New("batch size must be positive")
+)
+
+// Validate checks the settings of a worker that runs every interval and works through its
+// backlog in batches of batchSize. A zero interval would make its ticker panic and an empty
+// batch would never drain the backlog.
+func Validate(interval time.Duration, batchSize int) error {
+	if interval <= 0 {
+		return errors.Wrapf(ErrInvalidInterval, "got %s", interval)
+	}
+
+	if batchSize <= 0 {
+		return errors.Wrapf(ErrInvalidBatchSize, "got %d", batchSize)
+	}
+
+	return nil
+}